@@ -0,0 +1,117 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sentinel errors for the failure categories callers usually care about.
+// Every error returned for an HTTP error status wraps one of these, so they
+// can be checked with errors.Is.
+var (
+	ErrUnauthorized = errors.New("authentication failed")
+	ErrForbidden    = errors.New("access forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrValidation   = errors.New("validation failed")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// APIError describes a request that Redmine answered with an error status.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Body       string
+	RetryAfter time.Duration // from the Retry-After header, if present
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API request failed with status %d", e.StatusCode)
+	if kind := e.Unwrap(); kind != nil {
+		msg = fmt.Sprintf("%v: %s", kind, msg)
+	}
+	if body := strings.TrimSpace(e.Body); body != "" && !strings.HasPrefix(body, "<") {
+		msg += ": " + body
+	}
+	return msg
+}
+
+// Unwrap returns the sentinel error matching the status code, or nil for
+// statuses without a dedicated category.
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusForbidden:
+		return ErrForbidden
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode >= 500:
+		return ErrServer
+	}
+	return nil
+}
+
+// ValidationError is returned when Redmine rejects a request with
+// 422 Unprocessable Entity. Messages holds the server's error strings,
+// e.g. "Subject cannot be blank".
+type ValidationError struct {
+	Messages []string
+}
+
+func (e *ValidationError) Error() string {
+	return "validation failed: " + strings.Join(e.Messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// newStatusError builds the typed error for an error response.
+func newStatusError(resp *http.Response, method, path string, body []byte) error {
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		return parseValidationError(body)
+	}
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+		Body:       string(body),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+}
+
+// parseValidationError decodes Redmine's {"errors": [...]} response body.
+// If the body is not in the expected shape, the raw text is kept as the
+// single message so nothing the server said is lost.
+func parseValidationError(data []byte) *ValidationError {
+	var body struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil || len(body.Errors) == 0 {
+		msg := strings.TrimSpace(string(data))
+		if msg == "" {
+			msg = "request rejected by server"
+		}
+		return &ValidationError{Messages: []string{msg}}
+	}
+	return &ValidationError{Messages: body.Errors}
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds. HTTP-date
+// values are rare for Redmine and are ignored.
+func parseRetryAfter(v string) time.Duration {
+	secs, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,6 +16,32 @@ type Client struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+	Retry      RetryPolicy
+}
+
+// RetryPolicy controls how idempotent GET requests are retried after
+// network errors and 5xx responses. Delays grow exponentially from
+// BaseDelay and are capped at MaxDelay.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// DefaultRetryPolicy is used by NewClient.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 2,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   5 * time.Second,
+}
+
+// backoff returns the delay before the given retry attempt (1-based).
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	return d
 }
 
 func NewClient(baseURL, apiKey string) *Client {
@@ -23,12 +51,51 @@ func NewClient(baseURL, apiKey string) *Client {
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		Retry: DefaultRetryPolicy,
+	}
+}
+
+// doRequest performs a request and returns the response body. GET requests
+// are retried according to c.Retry; other methods are sent exactly once.
+func (c *Client) doRequest(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	attempts := 1
+	if method == http.MethodGet && c.Retry.MaxRetries > 0 {
+		attempts += c.Retry.MaxRetries
+	}
+
+	var data []byte
+	var err error
+	for attempt := 1; ; attempt++ {
+		data, err = c.doRequestOnce(ctx, method, path, body)
+		if err == nil || attempt >= attempts || !isRetryable(err) {
+			return data, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.Retry.backoff(attempt)):
+		}
+	}
+}
+
+// isRetryable reports whether a failed GET is worth repeating: network
+// errors and server-side (5xx) failures are, client errors and
+// cancellation are not.
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
 	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500
+	}
+	var verr *ValidationError
+	return !errors.As(err, &verr)
 }
 
-func (c *Client) doRequest(method, path string, body io.Reader) ([]byte, error) {
+func (c *Client) doRequestOnce(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
 	url := fmt.Sprintf("%s%s", c.BaseURL, path)
-	req, err := http.NewRequest(method, url, body)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -48,28 +115,55 @@ func (c *Client) doRequest(method, path string, body io.Reader) ([]byte, error)
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(data))
+		return nil, newStatusError(resp, method, path, data)
 	}
 
 	return data, nil
 }
 
 type Issue struct {
-	ID          int       `json:"id"`
-	Project     Project   `json:"project"`
-	Tracker     Tracker   `json:"tracker"`
-	Status      Status    `json:"status"`
-	Priority    Priority  `json:"priority"`
-	Author      User      `json:"author"`
-	AssignedTo  *User     `json:"assigned_to,omitempty"`
-	Subject     string    `json:"subject"`
-	Description string    `json:"description"`
-	StartDate   string    `json:"start_date,omitempty"`
-	DueDate     string    `json:"due_date,omitempty"`
-	DoneRatio   int       `json:"done_ratio"`
-	CreatedOn   time.Time `json:"created_on"`
-	UpdatedOn   time.Time `json:"updated_on"`
-	Journals    []Journal `json:"journals,omitempty"`
+	ID           int            `json:"id"`
+	Project      Project        `json:"project"`
+	Tracker      Tracker        `json:"tracker"`
+	Status       Status         `json:"status"`
+	Priority     Priority       `json:"priority"`
+	Author       User           `json:"author"`
+	AssignedTo   *User          `json:"assigned_to,omitempty"`
+	FixedVersion *Version       `json:"fixed_version,omitempty"` // target version (id and name only)
+	Category     *IssueCategory `json:"category,omitempty"`
+	Subject      string         `json:"subject"`
+	Description  string         `json:"description"`
+	StartDate    string         `json:"start_date,omitempty"`
+	DueDate      string         `json:"due_date,omitempty"`
+	DoneRatio    int            `json:"done_ratio"`
+	// Hours; a nil estimate is unset. The totals include subtasks.
+	EstimatedHours      *float64      `json:"estimated_hours,omitempty"`
+	TotalEstimatedHours *float64      `json:"total_estimated_hours,omitempty"`
+	SpentHours          float64       `json:"spent_hours,omitempty"`
+	TotalSpentHours     float64       `json:"total_spent_hours,omitempty"`
+	CreatedOn           time.Time     `json:"created_on"`
+	UpdatedOn           time.Time     `json:"updated_on"`
+	CustomFields        []CustomField `json:"custom_fields,omitempty"`
+	Journals            []Journal     `json:"journals,omitempty"`
+	Relations           []Relation    `json:"relations,omitempty"`
+}
+
+// Relation links two issues, e.g. "precedes" or "blocks". It is listed on
+// both issues; IssueID is always the source side.
+type Relation struct {
+	ID           int    `json:"id"`
+	IssueID      int    `json:"issue_id"`
+	IssueToID    int    `json:"issue_to_id"`
+	RelationType string `json:"relation_type"`
+	Delay        *int   `json:"delay,omitempty"`
+}
+
+// CustomField is a custom field value on an issue. Value is a string, or a
+// list of strings for multi-value fields.
+type CustomField struct {
+	ID    int         `json:"id"`
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
 }
 
 type JournalDetail struct {
@@ -80,11 +174,12 @@ type JournalDetail struct {
 }
 
 type Journal struct {
-	ID        int             `json:"id"`
-	User      User            `json:"user"`
-	Notes     string          `json:"notes"`
-	CreatedOn time.Time       `json:"created_on"`
-	Details   []JournalDetail `json:"details"`
+	ID           int             `json:"id"`
+	User         User            `json:"user"`
+	Notes        string          `json:"notes"`
+	PrivateNotes bool            `json:"private_notes,omitempty"`
+	CreatedOn    time.Time       `json:"created_on"`
+	Details      []JournalDetail `json:"details"`
 }
 
 type Project struct {
@@ -97,16 +192,32 @@ type Tracker struct {
 	Name string `json:"name"`
 }
 
-type Status struct {
+// IssueCategory is one of a project's issue categories
+type IssueCategory struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
 
+type Status struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	IsClosed bool   `json:"is_closed,omitempty"`
+}
+
 type Priority struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
 
+type Version struct {
+	ID          int     `json:"id"`
+	Project     Project `json:"project"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Status      string  `json:"status,omitempty"` // "open", "locked" or "closed"
+	DueDate     string  `json:"due_date,omitempty"`
+}
+
 type User struct {
 	ID        int    `json:"id"`
 	Login     string `json:"login"`
@@ -135,23 +246,74 @@ type ProjectsResponse struct {
 
 // GetIssues fetches issues with optional filters
 func (c *Client) GetIssues(projectID int, assignedToMe bool, assignedToUserId int, statusOpen bool, limit, offset int) (*IssuesResponse, error) {
-	params := url.Values{}
-	if projectID > 0 {
-		params.Set("project_id", fmt.Sprintf("%d", projectID))
-	}
+	return c.GetIssuesContext(context.Background(), projectID, assignedToMe, assignedToUserId, statusOpen, limit, offset)
+}
+
+// GetIssuesContext is like GetIssues but carries a context for cancellation.
+func (c *Client) GetIssuesContext(ctx context.Context, projectID int, assignedToMe bool, assignedToUserId int, statusOpen bool, limit, offset int) (*IssuesResponse, error) {
+	q := IssueQuery{ProjectID: projectID, Limit: limit, Offset: offset}
 	if assignedToMe {
-		params.Set("assigned_to_id", "me")
+		q.AssignedTo = "me"
 	} else if assignedToUserId > 0 {
-		params.Set("assigned_to_id", fmt.Sprintf("%d", assignedToUserId))
+		q.AssignedTo = fmt.Sprintf("%d", assignedToUserId)
 	}
 	if statusOpen {
-		params.Set("status_id", "open")
+		q.StatusID = "open"
 	}
-	params.Set("limit", fmt.Sprintf("%d", limit))
-	params.Set("offset", fmt.Sprintf("%d", offset))
+	return c.GetIssuesQuery(ctx, q)
+}
+
+// IssueQuery describes an /issues.json request. Zero-valued fields are
+// left out of the query string.
+type IssueQuery struct {
+	ProjectID      int
+	AssignedTo     string    // "me" or a user ID
+	WatcherID      string    // "me" or a user ID
+	StatusID       string    // "open", "closed", "*" or a status ID
+	FixedVersionID int       // target version
+	IssueIDs       []int     // only these issues
+	UpdatedSince   time.Time // only issues with updated_on >= this time
+	Sort           string    // e.g. "updated_on:desc"
+	Limit          int
+	Offset         int
+}
+
+// GetIssuesQuery fetches issues matching an IssueQuery
+func (c *Client) GetIssuesQuery(ctx context.Context, q IssueQuery) (*IssuesResponse, error) {
+	params := url.Values{}
+	if q.ProjectID > 0 {
+		params.Set("project_id", fmt.Sprintf("%d", q.ProjectID))
+	}
+	if q.AssignedTo != "" {
+		params.Set("assigned_to_id", q.AssignedTo)
+	}
+	if q.WatcherID != "" {
+		params.Set("watcher_id", q.WatcherID)
+	}
+	if q.StatusID != "" {
+		params.Set("status_id", q.StatusID)
+	}
+	if q.FixedVersionID > 0 {
+		params.Set("fixed_version_id", fmt.Sprintf("%d", q.FixedVersionID))
+	}
+	if len(q.IssueIDs) > 0 {
+		ids := make([]string, len(q.IssueIDs))
+		for i, id := range q.IssueIDs {
+			ids[i] = fmt.Sprintf("%d", id)
+		}
+		params.Set("issue_id", strings.Join(ids, ","))
+	}
+	if !q.UpdatedSince.IsZero() {
+		params.Set("updated_on", ">="+q.UpdatedSince.UTC().Format(time.RFC3339))
+	}
+	if q.Sort != "" {
+		params.Set("sort", q.Sort)
+	}
+	params.Set("limit", fmt.Sprintf("%d", q.Limit))
+	params.Set("offset", fmt.Sprintf("%d", q.Offset))
 
 	path := fmt.Sprintf("/issues.json?%s", params.Encode())
-	data, err := c.doRequest("GET", path, nil)
+	data, err := c.doRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -166,8 +328,13 @@ func (c *Client) GetIssues(projectID int, assignedToMe bool, assignedToUserId in
 
 // GetIssue fetches a single issue by ID
 func (c *Client) GetIssue(id int) (*Issue, error) {
-	path := fmt.Sprintf("/issues/%d.json?include=journals", id)
-	data, err := c.doRequest("GET", path, nil)
+	return c.GetIssueContext(context.Background(), id)
+}
+
+// GetIssueContext is like GetIssue but carries a context for cancellation.
+func (c *Client) GetIssueContext(ctx context.Context, id int) (*Issue, error) {
+	path := fmt.Sprintf("/issues/%d.json?include=journals,relations", id)
+	data, err := c.doRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -180,10 +347,41 @@ func (c *Client) GetIssue(id int) (*Issue, error) {
 	return &response.Issue, nil
 }
 
+// GetAllowedStatuses fetches the statuses the workflow allows the current
+// user to move an issue to (Redmine 5.0+). Older servers return none.
+func (c *Client) GetAllowedStatuses(issueID int) ([]Status, error) {
+	return c.GetAllowedStatusesContext(context.Background(), issueID)
+}
+
+// GetAllowedStatusesContext is like GetAllowedStatuses but carries a context for cancellation.
+func (c *Client) GetAllowedStatusesContext(ctx context.Context, issueID int) ([]Status, error) {
+	path := fmt.Sprintf("/issues/%d.json?include=allowed_statuses", issueID)
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Issue struct {
+			AllowedStatuses []Status `json:"allowed_statuses"`
+		} `json:"issue"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Issue.AllowedStatuses, nil
+}
+
 // GetCurrentUser fetches the current user information
 func (c *Client) GetCurrentUser() (*User, error) {
+	return c.GetCurrentUserContext(context.Background())
+}
+
+// GetCurrentUserContext is like GetCurrentUser but carries a context for cancellation.
+func (c *Client) GetCurrentUserContext(ctx context.Context) (*User, error) {
 	path := "/users/current.json"
-	data, err := c.doRequest("GET", path, nil)
+	data, err := c.doRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -200,12 +398,17 @@ func (c *Client) GetCurrentUser() (*User, error) {
 
 // GetProjects fetches all projects
 func (c *Client) GetProjects(limit, offset int) (*ProjectsResponse, error) {
+	return c.GetProjectsContext(context.Background(), limit, offset)
+}
+
+// GetProjectsContext is like GetProjects but carries a context for cancellation.
+func (c *Client) GetProjectsContext(ctx context.Context, limit, offset int) (*ProjectsResponse, error) {
 	params := url.Values{}
 	params.Set("limit", fmt.Sprintf("%d", limit))
 	params.Set("offset", fmt.Sprintf("%d", offset))
 
 	path := fmt.Sprintf("/projects.json?%s", params.Encode())
-	data, err := c.doRequest("GET", path, nil)
+	data, err := c.doRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -220,13 +423,18 @@ func (c *Client) GetProjects(limit, offset int) (*ProjectsResponse, error) {
 
 // GetUsers fetches all active users
 func (c *Client) GetUsers(limit, offset int) ([]User, error) {
+	return c.GetUsersContext(context.Background(), limit, offset)
+}
+
+// GetUsersContext is like GetUsers but carries a context for cancellation.
+func (c *Client) GetUsersContext(ctx context.Context, limit, offset int) ([]User, error) {
 	params := url.Values{}
 	params.Set("limit", fmt.Sprintf("%d", limit))
 	params.Set("offset", fmt.Sprintf("%d", offset))
 	params.Set("status", "1") // 1 = active users only
 
 	path := fmt.Sprintf("/users.json?%s", params.Encode())
-	data, err := c.doRequest("GET", path, nil)
+	data, err := c.doRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +451,11 @@ func (c *Client) GetUsers(limit, offset int) ([]User, error) {
 
 // UpdateIssue updates an issue
 func (c *Client) UpdateIssue(issueID int, updates map[string]interface{}) error {
+	return c.UpdateIssueContext(context.Background(), issueID, updates)
+}
+
+// UpdateIssueContext is like UpdateIssue but carries a context for cancellation.
+func (c *Client) UpdateIssueContext(ctx context.Context, issueID int, updates map[string]interface{}) error {
 	payload := map[string]interface{}{
 		"issue": updates,
 	}
@@ -253,14 +466,44 @@ func (c *Client) UpdateIssue(issueID int, updates map[string]interface{}) error
 	}
 
 	path := fmt.Sprintf("/issues/%d.json", issueID)
-	_, err = c.doRequest("PUT", path, strings.NewReader(string(jsonData)))
+	_, err = c.doRequest(ctx, "PUT", path, strings.NewReader(string(jsonData)))
+	return err
+}
+
+// UpdateJournal changes the notes and privacy of a journal entry (Redmine
+// 5.0+). Clearing the notes of an entry without field changes deletes it.
+func (c *Client) UpdateJournal(journalID int, notes string, private bool) error {
+	return c.UpdateJournalContext(context.Background(), journalID, notes, private)
+}
+
+// UpdateJournalContext is like UpdateJournal but carries a context for cancellation.
+func (c *Client) UpdateJournalContext(ctx context.Context, journalID int, notes string, private bool) error {
+	payload := map[string]interface{}{
+		"journal": map[string]interface{}{
+			"notes":         notes,
+			"private_notes": private,
+		},
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/journals/%d.json", journalID)
+	_, err = c.doRequest(ctx, "PUT", path, strings.NewReader(string(jsonData)))
 	return err
 }
 
 // GetStatuses fetches all available issue statuses
 func (c *Client) GetStatuses() ([]Status, error) {
+	return c.GetStatusesContext(context.Background())
+}
+
+// GetStatusesContext is like GetStatuses but carries a context for cancellation.
+func (c *Client) GetStatusesContext(ctx context.Context) ([]Status, error) {
 	path := "/issue_statuses.json"
-	data, err := c.doRequest("GET", path, nil)
+	data, err := c.doRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -277,8 +520,13 @@ func (c *Client) GetStatuses() ([]Status, error) {
 
 // GetPriorities fetches all available issue priorities
 func (c *Client) GetPriorities() ([]Priority, error) {
+	return c.GetPrioritiesContext(context.Background())
+}
+
+// GetPrioritiesContext is like GetPriorities but carries a context for cancellation.
+func (c *Client) GetPrioritiesContext(ctx context.Context) ([]Priority, error) {
 	path := "/enumerations/issue_priorities.json"
-	data, err := c.doRequest("GET", path, nil)
+	data, err := c.doRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -292,3 +540,191 @@ func (c *Client) GetPriorities() ([]Priority, error) {
 
 	return response.IssuePriorities, nil
 }
+
+// GetVersions fetches the versions available to a project's issues,
+// including versions shared from other projects
+func (c *Client) GetVersions(projectID int) ([]Version, error) {
+	return c.GetVersionsContext(context.Background(), projectID)
+}
+
+// GetVersionsContext is like GetVersions but carries a context for cancellation.
+func (c *Client) GetVersionsContext(ctx context.Context, projectID int) ([]Version, error) {
+	path := fmt.Sprintf("/projects/%d/versions.json", projectID)
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Versions []Version `json:"versions"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Versions, nil
+}
+
+// GetTrackers fetches all trackers
+func (c *Client) GetTrackers() ([]Tracker, error) {
+	return c.GetTrackersContext(context.Background())
+}
+
+// GetTrackersContext is like GetTrackers but carries a context for cancellation.
+func (c *Client) GetTrackersContext(ctx context.Context) ([]Tracker, error) {
+	path := "/trackers.json"
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Trackers []Tracker `json:"trackers"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Trackers, nil
+}
+
+// GetProjectTrackers fetches the trackers enabled for a project
+func (c *Client) GetProjectTrackers(projectID int) ([]Tracker, error) {
+	return c.GetProjectTrackersContext(context.Background(), projectID)
+}
+
+// GetProjectTrackersContext is like GetProjectTrackers but carries a context for cancellation.
+func (c *Client) GetProjectTrackersContext(ctx context.Context, projectID int) ([]Tracker, error) {
+	path := fmt.Sprintf("/projects/%d.json?include=trackers", projectID)
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Project struct {
+			Trackers []Tracker `json:"trackers"`
+		} `json:"project"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Project.Trackers, nil
+}
+
+// GetIssueCategories fetches a project's issue categories
+func (c *Client) GetIssueCategories(projectID int) ([]IssueCategory, error) {
+	return c.GetIssueCategoriesContext(context.Background(), projectID)
+}
+
+// GetIssueCategoriesContext is like GetIssueCategories but carries a context for cancellation.
+func (c *Client) GetIssueCategoriesContext(ctx context.Context, projectID int) ([]IssueCategory, error) {
+	path := fmt.Sprintf("/projects/%d/issue_categories.json", projectID)
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		IssueCategories []IssueCategory `json:"issue_categories"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return response.IssueCategories, nil
+}
+
+// GetUser fetches one user by ID. Unlike GetUsers it also finds locked
+// users (subject to the server's visibility rules).
+func (c *Client) GetUser(id int) (*User, error) {
+	return c.GetUserContext(context.Background(), id)
+}
+
+// GetUserContext is like GetUser but carries a context for cancellation.
+func (c *Client) GetUserContext(ctx context.Context, id int) (*User, error) {
+	path := fmt.Sprintf("/users/%d.json", id)
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		User User `json:"user"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.User, nil
+}
+
+// GetProject fetches one project by ID
+func (c *Client) GetProject(id int) (*Project, error) {
+	return c.GetProjectContext(context.Background(), id)
+}
+
+// GetProjectContext is like GetProject but carries a context for cancellation.
+func (c *Client) GetProjectContext(ctx context.Context, id int) (*Project, error) {
+	path := fmt.Sprintf("/projects/%d.json", id)
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Project Project `json:"project"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Project, nil
+}
+
+// GetVersion fetches one version by ID
+func (c *Client) GetVersion(id int) (*Version, error) {
+	return c.GetVersionContext(context.Background(), id)
+}
+
+// GetVersionContext is like GetVersion but carries a context for cancellation.
+func (c *Client) GetVersionContext(ctx context.Context, id int) (*Version, error) {
+	path := fmt.Sprintf("/versions/%d.json", id)
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Version Version `json:"version"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Version, nil
+}
+
+// GetIssueCategory fetches one issue category by ID
+func (c *Client) GetIssueCategory(id int) (*IssueCategory, error) {
+	return c.GetIssueCategoryContext(context.Background(), id)
+}
+
+// GetIssueCategoryContext is like GetIssueCategory but carries a context for cancellation.
+func (c *Client) GetIssueCategoryContext(ctx context.Context, id int) (*IssueCategory, error) {
+	path := fmt.Sprintf("/issue_categories/%d.json", id)
+	data, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		IssueCategory IssueCategory `json:"issue_category"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.IssueCategory, nil
+}
@@ -1,7 +1,12 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -54,3 +59,107 @@ func TestIssueStructure(t *testing.T) {
 		t.Errorf("Issue.Status.Name = %s, want New", issue.Status.Name)
 	}
 }
+
+func TestUpdateIssueValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"errors":["Subject cannot be blank","Due date must be greater than start date"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key")
+	err := client.UpdateIssue(1, map[string]interface{}{"subject": ""})
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("UpdateIssue() error = %v, want *ValidationError", err)
+	}
+	if len(verr.Messages) != 2 || verr.Messages[0] != "Subject cannot be blank" {
+		t.Errorf("ValidationError.Messages = %v", verr.Messages)
+	}
+}
+
+func TestErrorStatusesAreTyped(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusBadGateway, ErrServer},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+		}))
+		client := NewClient(server.URL, "test-key")
+		client.Retry.MaxRetries = 0
+
+		_, err := client.GetIssue(1)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("status %d: error = %v, want %v", tt.status, err, tt.want)
+		}
+		server.Close()
+	}
+}
+
+func TestGetRetriesServerErrors(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"issue":{"id":7,"subject":"Recovered"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key")
+	client.Retry = RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	issue, err := client.GetIssue(7)
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if issue.Subject != "Recovered" || calls != 3 {
+		t.Errorf("got subject %q after %d calls, want Recovered after 3", issue.Subject, calls)
+	}
+}
+
+func TestUpdateIsNotRetried(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key")
+	client.Retry = RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	if err := client.UpdateIssue(1, map[string]interface{}{"notes": "x"}); !errors.Is(err, ErrServer) {
+		t.Errorf("UpdateIssue() error = %v, want ErrServer", err)
+	}
+	if calls != 1 {
+		t.Errorf("PUT was sent %d times, want 1", calls)
+	}
+}
+
+func TestGetIssueContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.GetIssueContext(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetIssueContext() error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/config"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// openAPIKeyPrompt asks the user for a new API key after the server
+// rejected the current one.
+func (m *Model) openAPIKeyPrompt() tea.Cmd {
+	m.apiKeyMode = true
+	m.apiKeyErr = ""
+	m.apiKeyInput.SetValue("")
+	m.apiKeyInput.Focus()
+	return textinput.Blink
+}
+
+// requestErr returns the error carried by an API result message, if any
+func requestErr(msg tea.Msg) error {
+	switch msg := msg.(type) {
+	case issuesLoadedMsg:
+		return msg.err
+	case issueDetailMsg:
+		return msg.err
+	case detailPrefetchedMsg:
+		return msg.err
+	case currentUserMsg:
+		return msg.err
+	case usersLoadedMsg:
+		return msg.err
+	case projectsLoadedMsg:
+		return msg.err
+	case statusesLoadedMsg:
+		return msg.err
+	case prioritiesLoadedMsg:
+		return msg.err
+	case trackersLoadedMsg:
+		return msg.err
+	case projectTrackersLoadedMsg:
+		return msg.err
+	case categoriesLoadedMsg:
+		return msg.err
+	case versionsLoadedMsg:
+		return msg.err
+	case lookupLoadedMsg:
+		return msg.err
+	case issueUpdatedMsg:
+		return msg.err
+	case bulkItemDoneMsg:
+		return msg.err
+	case timelineSavedMsg:
+		return msg.err
+	case journalUpdatedMsg:
+		return msg.err
+	case issueChangesMsg:
+		return msg.err
+	case notificationPollMsg:
+		return msg.err
+	case versionIssuesMsg:
+		return msg.err
+	case gotoIssueMsg:
+		return msg.err
+	}
+	return nil
+}
+
+// updateAPIKeyPrompt handles keys while the API key prompt is open
+func (m Model) updateAPIKeyPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.apiKeyMode = false
+		m.apiKeyInput.Blur()
+		return m, nil
+	case "enter":
+		key := strings.TrimSpace(m.apiKeyInput.Value())
+		if key == "" {
+			return m, nil
+		}
+		if err := config.SaveAPIKey(key); err != nil {
+			// Keep the prompt open so the failure is visible
+			m.apiKeyErr = err.Error()
+			return m, nil
+		}
+		m.client.APIKey = key
+		m.apiKeyMode = false
+		m.apiKeyInput.Blur()
+		m.err = nil
+		m.loading = true
+		m.loadingIndicator.Show()
+		return m, tea.Batch(
+			appui.SendLoadingMsg("Fetching issues..."),
+			fetchIssues(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues),
+			appui.SendLoadingMsg("Fetching current user..."),
+			fetchCurrentUser(m.client),
+			appui.SendLoadingMsg("Fetching users..."),
+			fetchUsers(m.client),
+			appui.SendLoadingMsg("Fetching statuses..."),
+			fetchStatuses(m.client),
+		)
+	}
+	var cmd tea.Cmd
+	m.apiKeyInput, cmd = m.apiKeyInput.Update(msg)
+	return m, cmd
+}
+
+// renderAPIKeyPrompt renders the API key prompt as a centered modal
+func (m Model) renderAPIKeyPrompt() string {
+	body := "The server rejected the configured API key.\n" +
+		"Enter a new key (My account → API access key):\n\n" +
+		m.apiKeyInput.View()
+	if m.apiKeyErr != "" {
+		body += "\n\n" + lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error)).Render(m.apiKeyErr)
+	}
+	return appui.RenderInputModal(appui.InputModalConfig{
+		Title:       "Authentication failed",
+		Body:        body,
+		Hint:        "Enter: Save and retry   Esc: Cancel",
+		Width:       m.width,
+		Height:      m.height,
+		BorderColor: appui.Theme.Error,
+		TitleColor:  appui.Theme.Title,
+		BoxWidth:    66,
+	})
+}
@@ -0,0 +1,460 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// boardGroupings are the fields the board can be grouped by, in the order
+// the grouping key cycles through them
+var boardGroupings = []string{"status", "assignee", "priority", "tracker", "project"}
+
+// boardGroupFields maps a grouping to the issue field a card move changes.
+// Projects are not listed: moving issues between projects is left to edit mode.
+var boardGroupFields = map[string]string{
+	"status":   "status_id",
+	"assignee": "assigned_to_id",
+	"priority": "priority_id",
+	"tracker":  "tracker_id",
+}
+
+const boardMinColumnWidth = 24 // narrowest column content before the board scrolls sideways
+
+// boardColumn is one board column: a value of the grouped field and its issues
+type boardColumn struct {
+	Key    int // ID of the status/user/priority/tracker/project (0 = none)
+	Name   string
+	Issues []api.Issue
+}
+
+// issueGroupKey returns the ID and name of an issue's value for a grouping
+func issueGroupKey(issue api.Issue, group string) (int, string) {
+	switch group {
+	case "assignee":
+		if issue.AssignedTo == nil {
+			return 0, "Unassigned"
+		}
+		return issue.AssignedTo.ID, issue.AssignedTo.Name
+	case "priority":
+		return issue.Priority.ID, issue.Priority.Name
+	case "tracker":
+		return issue.Tracker.ID, issue.Tracker.Name
+	case "project":
+		return issue.Project.ID, issue.Project.Name
+	}
+	return issue.Status.ID, issue.Status.Name
+}
+
+// boardColumns groups the filtered issues into columns. Status and priority
+// columns follow the server's order and include empty ones so cards can be
+// moved there; other groupings get a column per value present, by name.
+func (m *Model) boardColumns() []boardColumn {
+	var cols []boardColumn
+	index := make(map[int]int)
+	add := func(key int, name string) {
+		if _, ok := index[key]; !ok {
+			index[key] = len(cols)
+			cols = append(cols, boardColumn{Key: key, Name: name})
+		}
+	}
+
+	switch m.boardGroup {
+	case "status":
+		for _, s := range m.availableStatuses {
+			add(s.ID, s.Name)
+		}
+	case "priority":
+		for _, p := range m.availablePriorities {
+			add(p.ID, p.Name)
+		}
+	}
+	preset := len(cols)
+
+	issues := m.getFilteredIssues()
+	for _, issue := range issues {
+		add(issueGroupKey(issue, m.boardGroup))
+	}
+	if preset == 0 {
+		sort.SliceStable(cols, func(i, j int) bool {
+			if (cols[i].Key == 0) != (cols[j].Key == 0) {
+				return cols[i].Key == 0 // "Unassigned" first
+			}
+			return strings.ToLower(cols[i].Name) < strings.ToLower(cols[j].Name)
+		})
+		for i, col := range cols {
+			index[col.Key] = i
+		}
+	}
+
+	for _, issue := range issues {
+		key, _ := issueGroupKey(issue, m.boardGroup)
+		i := index[key]
+		cols[i].Issues = append(cols[i].Issues, issue)
+	}
+	return cols
+}
+
+// clampBoard keeps the board cursor on an existing column and card
+func (m *Model) clampBoard(cols []boardColumn) {
+	if m.boardCol >= len(cols) {
+		m.boardCol = len(cols) - 1
+	}
+	if m.boardCol < 0 {
+		m.boardCol = 0
+	}
+	if len(cols) == 0 {
+		m.boardRow = 0
+		return
+	}
+	if n := len(cols[m.boardCol].Issues); m.boardRow >= n {
+		m.boardRow = n - 1
+	}
+	if m.boardRow < 0 {
+		m.boardRow = 0
+	}
+}
+
+// boardSelected returns the issue under the board cursor, if any
+func (m *Model) boardSelected(cols []boardColumn) (api.Issue, bool) {
+	if m.boardCol < 0 || m.boardCol >= len(cols) {
+		return api.Issue{}, false
+	}
+	col := cols[m.boardCol]
+	if m.boardRow < 0 || m.boardRow >= len(col.Issues) {
+		return api.Issue{}, false
+	}
+	return col.Issues[m.boardRow], true
+}
+
+// syncBoardSelection points the list selection at the board's card, so
+// actions like quick status, notes and marking apply to it.
+func (m *Model) syncBoardSelection(cols []boardColumn) {
+	issue, ok := m.boardSelected(cols)
+	if !ok {
+		return
+	}
+	for i, fi := range m.getFilteredIssues() {
+		if fi.ID == issue.ID {
+			m.selectedIndex = i
+			return
+		}
+	}
+}
+
+// focusBoardIssue moves the board cursor onto the given issue
+func (m *Model) focusBoardIssue(cols []boardColumn, issueID int) {
+	for c, col := range cols {
+		for r, issue := range col.Issues {
+			if issue.ID == issueID {
+				m.boardCol, m.boardRow = c, r
+				return
+			}
+		}
+	}
+}
+
+// openBoard switches to the board, starting on the selected issue
+func (m *Model) openBoard() tea.Cmd {
+	m.boardMode = true
+	m.viewNotice = ""
+	if m.boardGroup == "" {
+		m.boardGroup = "status"
+	}
+	cols := m.boardColumns()
+	if id := m.selectedIssueID(); id != 0 {
+		m.focusBoardIssue(cols, id)
+	}
+	m.clampBoard(cols)
+	if len(m.availableStatuses) == 0 {
+		return tea.Batch(appui.SendLoadingMsg("Fetching statuses..."), fetchStatuses(m.client))
+	}
+	return nil
+}
+
+// updateBoard handles the board's own keys. It reports false for keys the
+// board does not use, which then fall through to the normal bindings.
+func (m *Model) updateBoard(msg tea.KeyMsg) (tea.Cmd, bool) {
+	m.viewNotice = ""
+	cols := m.boardColumns()
+	m.clampBoard(cols)
+
+	if msg.String() == "esc" || key.Matches(msg, m.keys.Board) {
+		m.boardMode = false
+		m.updatePaneContent()
+		return nil, true
+	}
+
+	switch msg.String() {
+	case "enter", "e":
+		// Back to the list/details panes on the selected card
+		m.syncBoardSelection(cols)
+		m.boardMode = false
+		m.updatePaneContent()
+		if issue, ok := m.boardSelected(cols); ok {
+			return m.loadDetail(issue.ID), true
+		}
+		return nil, true
+	case "left", "h":
+		if m.boardCol > 0 {
+			m.boardCol--
+		}
+	case "right", "l":
+		if m.boardCol < len(cols)-1 {
+			m.boardCol++
+		}
+	case "up", "k":
+		if m.boardRow > 0 {
+			m.boardRow--
+		}
+	case "down", "j":
+		m.boardRow++
+	case "home":
+		m.boardRow = 0
+	case "end":
+		m.boardRow = len(m.getFilteredIssues())
+	case "H", "shift+left":
+		return m.moveBoardCard(cols, -1), true
+	case "L", "shift+right":
+		return m.moveBoardCard(cols, 1), true
+	case "g":
+		// Cycle the grouping, keeping the same card selected
+		issue, ok := m.boardSelected(cols)
+		for i, g := range boardGroupings {
+			if g == m.boardGroup {
+				m.boardGroup = boardGroupings[(i+1)%len(boardGroupings)]
+				break
+			}
+		}
+		m.boardCol, m.boardRow = 0, 0
+		cols = m.boardColumns()
+		if ok {
+			m.focusBoardIssue(cols, issue.ID)
+		}
+		if m.boardGroup == "priority" && len(m.availablePriorities) == 0 {
+			return tea.Batch(appui.SendLoadingMsg("Fetching priorities..."), fetchPriorities(m.client)), true
+		}
+	default:
+		return nil, false
+	}
+
+	m.clampBoard(cols)
+	m.syncBoardSelection(cols)
+	return nil, true
+}
+
+// moveBoardCard moves the selected card to the neighbouring column by
+// updating the grouped field. The card moves right away and only the issue
+// is fetched again once saved; if the server (or the status workflow)
+// rejects the change, the list is reloaded.
+func (m *Model) moveBoardCard(cols []boardColumn, delta int) tea.Cmd {
+	issue, ok := m.boardSelected(cols)
+	target := m.boardCol + delta
+	if !ok || target < 0 || target >= len(cols) {
+		return nil
+	}
+	field, movable := boardGroupFields[m.boardGroup]
+	if !movable {
+		m.viewNotice = fmt.Sprintf("Cards can't be moved between %ss on the board", m.boardGroup)
+		return nil
+	}
+
+	col := cols[target]
+	var value interface{} = col.Key
+	if field == "assigned_to_id" && col.Key == 0 {
+		value = nil
+	}
+	updates := map[string]interface{}{field: value}
+	change := m.recordChange(issue.ID, updates)
+
+	// Move the card locally so the board responds immediately
+	for i := range m.issues {
+		if m.issues[i].ID != issue.ID {
+			continue
+		}
+		switch m.boardGroup {
+		case "status":
+			m.issues[i].Status = api.Status{ID: col.Key, Name: col.Name}
+		case "assignee":
+			if col.Key == 0 {
+				m.issues[i].AssignedTo = nil
+			} else {
+				m.issues[i].AssignedTo = &api.User{ID: col.Key, Name: col.Name}
+			}
+		case "priority":
+			m.issues[i].Priority = api.Priority{ID: col.Key, Name: col.Name}
+		case "tracker":
+			m.issues[i].Tracker = api.Tracker{ID: col.Key, Name: col.Name}
+		}
+	}
+	m.focusBoardIssue(m.boardColumns(), issue.ID)
+
+	m.loading = true
+	return tea.Batch(
+		appui.SendLoadingMsg(fmt.Sprintf("Moving #%d to %s...", issue.ID, col.Name)),
+		moveIssue(m.client, issue.ID, updates, col.Name, change),
+	)
+}
+
+// moveIssue applies a board move. Status moves are checked against the
+// workflow first, where the server can report the allowed statuses.
+func moveIssue(client *api.Client, issueID int, updates map[string]interface{}, targetName string, change *changeRecord) tea.Cmd {
+	return func() tea.Msg {
+		if statusID, ok := updates["status_id"].(int); ok {
+			allowed, err := client.GetAllowedStatuses(issueID)
+			if err == nil && len(allowed) > 0 {
+				permitted := false
+				for _, s := range allowed {
+					if s.ID == statusID {
+						permitted = true
+						break
+					}
+				}
+				if !permitted {
+					return issueUpdatedMsg{issueID: issueID, err: fmt.Errorf("the workflow does not allow moving #%d to %s", issueID, targetName)}
+				}
+			}
+		}
+		err := client.UpdateIssue(issueID, updates)
+		return issueUpdatedMsg{issueID: issueID, change: change, local: true, err: err}
+	}
+}
+
+// renderBoard renders the board in place of the list and details panes
+func (m Model) renderBoard() string {
+	cols := m.boardColumns()
+	height := m.leftPane.Height
+
+	if len(cols) == 0 {
+		empty := lipgloss.NewStyle().
+			Width(m.width - 4).
+			Height(height).
+			Foreground(lipgloss.Color(appui.Theme.Muted)).
+			Render("No issues to show.")
+		return appui.RenderPane(appui.PaneConfig{Content: empty, Title: m.boardTitle(), Width: m.width - 4, IsActive: true})
+	}
+
+	// Fit as many columns as the width allows and scroll to the cursor
+	perRow := m.width / (boardMinColumnWidth + 4)
+	if perRow < 1 {
+		perRow = 1
+	}
+	if perRow > len(cols) {
+		perRow = len(cols)
+	}
+	offset := 0
+	if m.boardCol >= perRow {
+		offset = m.boardCol - perRow + 1
+	}
+	colWidth := m.width/perRow - 4
+
+	var rendered []string
+	for c := offset; c < offset+perRow && c < len(cols); c++ {
+		col := cols[c]
+		active := c == m.boardCol
+		title := fmt.Sprintf("%s (%d)", col.Name, len(col.Issues))
+		if c == offset && offset > 0 {
+			title = "< " + title
+		}
+		if c == offset+perRow-1 && c < len(cols)-1 {
+			title += " >"
+		}
+		rendered = append(rendered, appui.RenderPane(appui.PaneConfig{
+			Content:  m.renderBoardColumn(col, active, colWidth, height),
+			Title:    title,
+			Width:    colWidth,
+			IsActive: active,
+			ShowDot:  active,
+		}))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
+}
+
+// renderBoardColumn renders a column's cards, three lines each, scrolled
+// to keep the selected card in view
+func (m Model) renderBoardColumn(col boardColumn, active bool, width, height int) string {
+	idStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent)).Bold(true)
+	subjectStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Title))
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	markStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Special)).Bold(true)
+	updatedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning)).Bold(true)
+	selectedStyle := appui.SelectedStyle().Width(width)
+
+	const cardHeight = 3
+	start := 0
+	if visible := height / cardHeight; active && visible > 0 && m.boardRow >= visible {
+		start = m.boardRow - visible + 1
+	}
+
+	var lines []string
+	for r := start; r < len(col.Issues); r++ {
+		issue := col.Issues[r]
+		prefix := ""
+		if m.markedIssues[issue.ID] {
+			prefix += markStyle.Render("✓ ")
+		}
+		if m.unseenUpdates[issue.ID] {
+			prefix += updatedStyle.Render("● ")
+		}
+		id := fmt.Sprintf("#%d", issue.ID)
+		room := width - lipgloss.Width(prefix) - len(id) - 1
+		if room < 1 {
+			room = 1
+		}
+		line1 := prefix + idStyle.Render(id) + " " + subjectStyle.Render(excerpt(issue.Subject, room))
+
+		// Second line: the fields the columns don't already show
+		var details []string
+		if m.boardGroup != "assignee" {
+			_, name := issueGroupKey(issue, "assignee")
+			details = append(details, name)
+		}
+		if m.boardGroup != "status" {
+			details = append(details, issue.Status.Name)
+		}
+		if m.boardGroup != "priority" {
+			details = append(details, issue.Priority.Name)
+		}
+		line2 := dimStyle.Render(excerpt(strings.Join(details, " · "), width))
+
+		if active && r == m.boardRow {
+			line1 = selectedStyle.Render(line1)
+			line2 = selectedStyle.Render(line2)
+		}
+		lines = append(lines, line1, line2, "")
+	}
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	return lipgloss.NewStyle().Width(width).Height(height).Render(strings.Join(lines, "\n"))
+}
+
+// boardTitle describes the issues and grouping shown on the board
+func (m Model) boardTitle() string {
+	return fmt.Sprintf("%s by %s", m.leftTitle, m.boardGroup)
+}
+
+// getBoardFooterItems returns footer menu items for the board
+func (m Model) getBoardFooterItems() []appui.FooterItem {
+	items := []appui.FooterItem{
+		{Text: "←→↑↓/hjkl: Nav", Required: true},
+		{Text: "H/L: Move card", Required: true},
+		{Text: "g: Group by " + m.boardGroup, Required: true},
+		{Text: "Enter: Open", Required: true},
+		{Text: "a: Actions", Required: false},
+		{Text: "s: Status", Required: false},
+		{Text: "z: Undo", Required: false},
+		{Text: "B/Esc: List", Required: true},
+	}
+	if m.viewNotice != "" {
+		items = append([]appui.FooterItem{{Text: m.viewNotice, Required: true}}, items...)
+	}
+	return items
+}
@@ -0,0 +1,233 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/config"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// browserOpenedMsg reports whether a URL could be handed to the browser
+type browserOpenedMsg struct {
+	url string
+	err error
+}
+
+// browserOption is one page the open menu offers
+type browserOption struct {
+	label string
+	url   string
+}
+
+// queryURL returns the web URL of the issue list the app is showing: the
+// same assignee/project selection and open issues. The text filter has no
+// web equivalent and is left out.
+func (m *Model) queryURL() string {
+	params := url.Values{}
+	params.Set("set_filter", "1")
+	params.Set("status_id", "o")
+	switch {
+	case m.viewMode == "my":
+		params.Set("assigned_to_id", "me")
+	case m.assigneeFilter != "":
+		params.Set("assigned_to_id", strings.ReplaceAll(m.assigneeFilter, ",", "|"))
+	}
+	if m.projectFilter != "" {
+		params.Set("project_id", strings.ReplaceAll(m.projectFilter, ",", "|"))
+	}
+	return m.client.BaseURL + "/issues?" + params.Encode()
+}
+
+// browserOptions lists the pages the open menu offers for the selected issue
+func (m *Model) browserOptions() []browserOption {
+	var options []browserOption
+	if issue := m.editingIssue(); issue != nil {
+		options = append(options,
+			browserOption{fmt.Sprintf("Issue #%d", issue.ID), m.issueURL(issue.ID)},
+			browserOption{"Project " + issue.Project.Name, fmt.Sprintf("%s/projects/%d", m.client.BaseURL, issue.Project.ID)},
+		)
+	}
+	query := "Current query"
+	if m.filterText != "" {
+		query += " (without the text filter)"
+	}
+	return append(options, browserOption{query, m.queryURL()})
+}
+
+// openBrowserMenu shows what can be opened in the browser
+func (m *Model) openBrowserMenu() {
+	m.showModal = true
+	m.modalType = "browser"
+	m.browserCursor = 0
+	m.browserURL = ""
+}
+
+// updateBrowserMenu handles keys in the open menu and in the URL modal
+// shown when no browser could be started
+func (m Model) updateBrowserMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.browserURL != "" {
+		switch msg.String() {
+		case "y":
+			m.showModal = false
+			m.modalType = ""
+			return m, copyToClipboard(m.browserURL)
+		case "esc", "q", "enter", "o":
+			m.showModal = false
+			m.modalType = ""
+		}
+		return m, nil
+	}
+
+	options := m.browserOptions()
+	pick := -1
+	switch msg.String() {
+	case "esc", "q":
+		m.showModal = false
+		m.modalType = ""
+	case "up", "k":
+		if m.browserCursor > 0 {
+			m.browserCursor--
+		}
+	case "down", "j":
+		if m.browserCursor < len(options)-1 {
+			m.browserCursor++
+		}
+	case "enter", "o":
+		pick = m.browserCursor
+	default:
+		if s := msg.String(); len(s) == 1 && s[0] >= '1' && s[0] <= '9' {
+			pick = int(s[0] - '1')
+		}
+	}
+	if pick < 0 || pick >= len(options) {
+		return m, nil
+	}
+	m.showModal = false
+	m.modalType = ""
+	return m, openInBrowser(options[pick].url)
+}
+
+// showURL shows a URL that couldn't be opened so it can be copied instead
+func (m *Model) showURL(u string, err error) {
+	m.showModal = true
+	m.modalType = "browser"
+	m.browserURL = u
+	m.browserErr = err
+}
+
+// renderBrowserMenu renders the open menu, or the URL that couldn't be opened
+func (m Model) renderBrowserMenu() string {
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent))
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error))
+
+	if m.browserURL != "" {
+		lines := []string{errorStyle.Render("Couldn't open a browser: " + m.browserErr.Error()), ""}
+		for _, l := range strings.Split(lipgloss.NewStyle().Width(58).Render(m.browserURL), "\n") {
+			lines = append(lines, labelStyle.Render(l))
+		}
+		return appui.RenderModal(appui.ModalConfig{
+			Title:       "Open in browser (y: copy URL, Esc: close)",
+			Content:     lines,
+			Width:       m.width,
+			Height:      m.height,
+			BorderColor: appui.Theme.Error,
+			TitleColor:  appui.Theme.Title,
+		})
+	}
+
+	options := m.browserOptions()
+	var lines []string
+	for i, o := range options {
+		prefix := "  "
+		label := labelStyle.Render(fmt.Sprintf("%d. %s", i+1, o.label))
+		if i == m.browserCursor {
+			prefix = "→ "
+			label = cursorStyle.Render(fmt.Sprintf("%d. %s", i+1, o.label))
+		}
+		lines = append(lines, prefix+label, "     "+dimStyle.Render(excerpt(o.url, 54)))
+	}
+	return appui.RenderModal(appui.ModalConfig{
+		Title:       fmt.Sprintf("Open in browser (1-%d/Enter: open, Esc: close)", len(options)),
+		Content:     lines,
+		Width:       m.width,
+		Height:      m.height,
+		BorderColor: appui.Theme.Accent,
+		TitleColor:  appui.Theme.Title,
+	})
+}
+
+// browserCommand returns the command that opens a URL: the configured
+// browser.command, then $BROWSER, then the platform's opener. The
+// platform openers return right away, so their exit status is waited for;
+// browsers themselves keep running. Without a display (e.g. over SSH)
+// xdg-open can't work and nothing is returned.
+func browserCommand() (fields []string, wait bool) {
+	if fields := strings.Fields(config.Current.Browser.Command); len(fields) > 0 {
+		return fields, false
+	}
+	if env := os.Getenv("BROWSER"); env != "" {
+		// $BROWSER may list several commands separated by colons
+		if fields := strings.Fields(strings.Split(env, ":")[0]); len(fields) > 0 {
+			return fields, false
+		}
+	}
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{"open"}, true
+	case "windows":
+		return []string{"rundll32", "url.dll,FileProtocolHandler"}, true
+	default:
+		if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+			return nil, false
+		}
+		return []string{"xdg-open"}, true
+	}
+}
+
+// openInBrowser starts the browser on a URL without waiting for it. The
+// URL replaces a "%s" in the command, or is appended.
+func openInBrowser(u string) tea.Cmd {
+	return func() tea.Msg {
+		fields, wait := browserCommand()
+		if len(fields) == 0 {
+			return browserOpenedMsg{url: u, err: errors.New("no browser configured and no display")}
+		}
+		args := append([]string{}, fields[1:]...)
+		replaced := false
+		for i, a := range args {
+			if strings.Contains(a, "%s") {
+				args[i] = strings.ReplaceAll(a, "%s", u)
+				replaced = true
+			}
+		}
+		if !replaced {
+			args = append(args, u)
+		}
+		if _, err := exec.LookPath(fields[0]); err != nil {
+			return browserOpenedMsg{url: u, err: errors.New(fields[0] + " not found")}
+		}
+		cmd := exec.Command(fields[0], args...)
+		if wait {
+			if err := cmd.Run(); err != nil {
+				return browserOpenedMsg{url: u, err: fmt.Errorf("%s: %w", fields[0], err)}
+			}
+			return browserOpenedMsg{url: u}
+		}
+		if err := cmd.Start(); err != nil {
+			return browserOpenedMsg{url: u, err: err}
+		}
+		go func() { _ = cmd.Wait() }() // reap it; the exit status doesn't matter
+		return browserOpenedMsg{url: u}
+	}
+}
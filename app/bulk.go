@@ -0,0 +1,378 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// bulkConcurrency bounds the UpdateIssue calls a bulk update runs at once
+const bulkConcurrency = 4
+
+// bulkItem tracks one issue's progress in a bulk update
+type bulkItem struct {
+	IssueID int
+	Subject string
+	Done    bool
+	Err     error
+}
+
+// bulkItemDoneMsg reports the result of updating one issue in a bulk update
+type bulkItemDoneMsg struct {
+	issueID int
+	change  *changeRecord // previous values, logged for undo on success
+	err     error
+}
+
+type versionsLoadedMsg struct {
+	projectID int
+	versions  []api.Version
+	err       error
+}
+
+func fetchVersions(client *api.Client, projectID int) tea.Cmd {
+	return func() tea.Msg {
+		versions, err := client.GetVersions(projectID)
+		return versionsLoadedMsg{projectID: projectID, versions: versions, err: err}
+	}
+}
+
+// cycleIndex steps a select index by delta, wrapping around n options.
+// With keep set, index -1 ("no change") is part of the cycle.
+func cycleIndex(idx, n, delta int, keep bool) int {
+	if keep {
+		return (idx+1+delta+n+1)%(n+1) - 1
+	}
+	if n == 0 {
+		return 0
+	}
+	return (idx + delta + n) % n
+}
+
+// quickFields lists the quick-actions popup fields in Tab order. Bulk
+// mode adds priority and version.
+func (m *Model) quickFields() []string {
+	if len(m.quickBulkIDs) > 0 {
+		return []string{"status", "assignee", "priority", "version", "note"}
+	}
+	return []string{"status", "assignee", "note"}
+}
+
+// quickFieldName returns the name of the focused quick-actions field
+func (m *Model) quickFieldName() string {
+	fields := m.quickFields()
+	if m.quickField < 0 || m.quickField >= len(fields) {
+		return ""
+	}
+	return fields[m.quickField]
+}
+
+// quickVersionOptions returns the open versions usable by the issues the
+// popup applies to, de-duplicated (shared versions appear per project).
+func (m *Model) quickVersionOptions() []api.Version {
+	seen := make(map[int]bool)
+	var out []api.Version
+	for _, projectID := range m.bulkProjectIDs() {
+		for _, v := range m.projectVersions[projectID] {
+			if seen[v.ID] || v.Status == "closed" {
+				continue
+			}
+			seen[v.ID] = true
+			out = append(out, v)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// bulkProjectIDs returns the distinct projects of the issues in the popup
+func (m *Model) bulkProjectIDs() []int {
+	targets := make(map[int]bool)
+	for _, id := range m.quickBulkIDs {
+		targets[id] = true
+	}
+	seen := make(map[int]bool)
+	var out []int
+	for _, issue := range m.issues {
+		if targets[issue.ID] && !seen[issue.Project.ID] {
+			seen[issue.Project.ID] = true
+			out = append(out, issue.Project.ID)
+		}
+	}
+	return out
+}
+
+// quickUpdates collects the changes selected in the quick-actions popup
+func (m *Model) quickUpdates() map[string]interface{} {
+	updates := make(map[string]interface{})
+	if m.quickStatusIdx >= 0 && m.quickStatusIdx < len(m.availableStatuses) {
+		if st := m.availableStatuses[m.quickStatusIdx]; st.ID != m.quickOrigStatusID {
+			updates["status_id"] = st.ID
+		}
+	}
+	opts := m.quickFilteredAssignees()
+	if len(opts) > 0 && m.quickAssigneeSel < len(opts) {
+		if sel := opts[m.quickAssigneeSel]; sel.ID != m.quickOrigAssigneeID {
+			if sel.ID == 0 {
+				updates["assigned_to_id"] = nil
+			} else {
+				updates["assigned_to_id"] = sel.ID
+			}
+		}
+	}
+	if len(m.quickBulkIDs) > 0 {
+		if m.quickPriorityIdx >= 0 && m.quickPriorityIdx < len(m.availablePriorities) {
+			updates["priority_id"] = m.availablePriorities[m.quickPriorityIdx].ID
+		}
+		if versions := m.quickVersionOptions(); m.quickVersionIdx >= 0 && m.quickVersionIdx < len(versions) {
+			updates["fixed_version_id"] = versions[m.quickVersionIdx].ID
+		}
+	}
+	if note := strings.TrimSpace(m.quickNote.Value()); note != "" {
+		updates["notes"] = note
+		if m.quickNotePrivate {
+			updates["private_notes"] = true
+		}
+	}
+	return updates
+}
+
+// markedIDs returns the marked issue IDs in list order
+func (m *Model) markedIDs() []int {
+	var ids []int
+	for _, issue := range m.issues {
+		if m.markedIssues[issue.ID] {
+			ids = append(ids, issue.ID)
+		}
+	}
+	return ids
+}
+
+// toggleMark marks or unmarks the selected issue and makes it the anchor
+// for range marking
+func (m *Model) toggleMark() {
+	filteredIssues := m.getFilteredIssues()
+	if m.selectedIndex < 0 || m.selectedIndex >= len(filteredIssues) {
+		return
+	}
+	id := filteredIssues[m.selectedIndex].ID
+	if m.markedIssues[id] {
+		delete(m.markedIssues, id)
+	} else {
+		m.markedIssues[id] = true
+	}
+	m.markAnchor = id
+}
+
+// markRange marks every issue between the anchor and the cursor. The
+// anchor is found by ID, so it survives reloads and filtering; without one
+// (or when it is no longer listed) nothing is marked.
+func (m *Model) markRange() {
+	filteredIssues := m.getFilteredIssues()
+	from := -1
+	for i, issue := range filteredIssues {
+		if issue.ID == m.markAnchor {
+			from = i
+			break
+		}
+	}
+	if m.markAnchor == 0 || from < 0 {
+		m.viewNotice = "Mark an issue first, then move and mark the range"
+		return
+	}
+	to := m.selectedIndex
+	if from > to {
+		from, to = to, from
+	}
+	for i := from; i <= to && i < len(filteredIssues); i++ {
+		if i >= 0 {
+			m.markedIssues[filteredIssues[i].ID] = true
+		}
+	}
+}
+
+// clearMarks removes all marks and the range anchor
+func (m *Model) clearMarks() {
+	m.markedIssues = make(map[int]bool)
+	m.markAnchor = 0
+}
+
+// toggleMarkAll marks all filtered issues, or clears the marks if they
+// are all marked already
+func (m *Model) toggleMarkAll() {
+	filteredIssues := m.getFilteredIssues()
+	allMarked := len(filteredIssues) > 0
+	for _, issue := range filteredIssues {
+		if !m.markedIssues[issue.ID] {
+			allMarked = false
+			break
+		}
+	}
+	if allMarked {
+		m.markAnchor = 0
+	}
+	for _, issue := range filteredIssues {
+		if allMarked {
+			delete(m.markedIssues, issue.ID)
+		} else {
+			m.markedIssues[issue.ID] = true
+		}
+	}
+}
+
+// openBulkActions opens the quick-actions popup for all marked issues.
+// Every field starts at "(no change)".
+func (m *Model) openBulkActions() tea.Cmd {
+	m.quickBulkIDs = m.markedIDs()
+	m.quickIssueID = 0
+	m.quickField = 0
+	m.quickOrigStatusID = 0
+	m.quickStatusIdx = -1
+	m.quickPriorityIdx = -1
+	m.quickVersionIdx = -1
+	m.quickAssigneeFilter = ""
+	m.quickOrigAssigneeID = -1
+	m.quickAssigneeSel = 0
+	m.quickNote.Reset()
+	m.quickNote.Blur()
+	m.quickNotePrivate = false
+	m.quickMode = true
+
+	var cmds []tea.Cmd
+	if len(m.availableStatuses) == 0 {
+		cmds = append(cmds, appui.SendLoadingMsg("Fetching statuses..."), fetchStatuses(m.client))
+	}
+	if len(m.availablePriorities) == 0 {
+		cmds = append(cmds, appui.SendLoadingMsg("Fetching priorities..."), fetchPriorities(m.client))
+	}
+	if len(m.availableUsers) == 0 {
+		cmds = append(cmds, appui.SendLoadingMsg("Fetching users..."), fetchUsers(m.client))
+	}
+	for _, projectID := range m.bulkProjectIDs() {
+		if _, ok := m.projectVersions[projectID]; !ok {
+			cmds = append(cmds, appui.SendLoadingMsg("Fetching versions..."), fetchVersions(m.client, projectID))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// startBulkUpdate applies the same updates to every issue, at most
+// bulkConcurrency at a time, and opens the progress report.
+func (m *Model) startBulkUpdate(ids []int, updates map[string]interface{}) tea.Cmd {
+	subjects := make(map[int]string)
+	for _, issue := range m.issues {
+		subjects[issue.ID] = issue.Subject
+	}
+
+	m.bulkItems = make([]bulkItem, len(ids))
+	m.bulkRunning = len(ids)
+	sem := make(chan struct{}, bulkConcurrency)
+	cmds := []tea.Cmd{appui.SendLoadingMsg(fmt.Sprintf("Updating %d issues...", len(ids)))}
+	for i, id := range ids {
+		m.bulkItems[i] = bulkItem{IssueID: id, Subject: subjects[id]}
+		cmds = append(cmds, bulkUpdateIssue(sem, m.client, id, updates, m.recordChange(id, updates)))
+	}
+
+	m.showModal = true
+	m.modalType = "bulk"
+	m.modalScroll = 0
+	return tea.Batch(cmds...)
+}
+
+// bulkUpdateIssue updates one issue while holding a slot in sem
+func bulkUpdateIssue(sem chan struct{}, client *api.Client, issueID int, updates map[string]interface{}, change *changeRecord) tea.Cmd {
+	return func() tea.Msg {
+		sem <- struct{}{}
+		defer func() { <-sem }()
+		err := client.UpdateIssueContext(context.Background(), issueID, updates)
+		return bulkItemDoneMsg{issueID: issueID, change: change, err: err}
+	}
+}
+
+// finishBulkItem records one result, logging successful changes so each
+// can be undone. Once all are in, only the failed issues stay marked and
+// the list is refreshed.
+func (m *Model) finishBulkItem(msg bulkItemDoneMsg) tea.Cmd {
+	for i := range m.bulkItems {
+		if m.bulkItems[i].IssueID == msg.issueID && !m.bulkItems[i].Done {
+			m.bulkItems[i].Done = true
+			m.bulkItems[i].Err = msg.err
+			m.bulkRunning--
+			if msg.err == nil && msg.change != nil {
+				m.logChange(msg.change)
+			}
+			break
+		}
+	}
+	if m.bulkRunning > 0 {
+		return nil
+	}
+
+	m.clearMarks()
+	for _, item := range m.bulkItems {
+		if item.Err != nil {
+			m.markedIssues[item.IssueID] = true
+		}
+	}
+	m.loading = true
+	return tea.Batch(
+		appui.SendLoadingCompleteMsg(),
+		appui.SendLoadingMsg("Refreshing issues..."),
+		fetchIssues(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues),
+	)
+}
+
+// renderBulkProgress renders the per-issue progress report and summary
+func (m Model) renderBulkProgress() string {
+	okStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))
+	failStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error))
+	pendingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning))
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+
+	done, failed := 0, 0
+	var lines []string
+	for _, item := range m.bulkItems {
+		label := fmt.Sprintf("#%d %s", item.IssueID, excerpt(item.Subject, 44))
+		switch {
+		case !item.Done:
+			lines = append(lines, pendingStyle.Render("● ")+label)
+		case item.Err != nil:
+			failed++
+			lines = append(lines, failStyle.Render("✗ ")+label)
+			lines = append(lines, "    "+failStyle.Render(excerpt(item.Err.Error(), 54)))
+		default:
+			lines = append(lines, okStyle.Render("✓ ")+label)
+		}
+		if item.Done {
+			done++
+		}
+	}
+
+	var summary string
+	if m.bulkRunning > 0 {
+		summary = pendingStyle.Render(fmt.Sprintf("Updating… %d/%d done", done, len(m.bulkItems)))
+	} else if failed > 0 {
+		summary = failStyle.Render(fmt.Sprintf("%d updated, %d failed", done-failed, failed)) +
+			dimStyle.Render(" (failed issues stay marked)")
+	} else {
+		summary = okStyle.Render(fmt.Sprintf("All %d issues updated", done))
+	}
+	lines = append([]string{summary, ""}, lines...)
+
+	return appui.RenderModal(appui.ModalConfig{
+		Title:        "Bulk update",
+		Content:      lines,
+		Width:        m.width,
+		Height:       m.height,
+		BorderColor:  appui.Theme.Special,
+		TitleColor:   appui.Theme.Title,
+		ScrollOffset: m.modalScroll,
+	})
+}
@@ -0,0 +1,221 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+const calendarCellWidth = 8 // characters per day column
+
+// calendarDate returns the date the calendar places an issue on: its due
+// date, or its start date when toggled
+func (m *Model) calendarDate(issue api.Issue) (time.Time, bool) {
+	if m.calendarUseStart {
+		return parseDate(issue.StartDate)
+	}
+	return parseDate(issue.DueDate)
+}
+
+// calendarIssues returns the filtered issues falling on each date
+func (m *Model) calendarIssues() map[string][]api.Issue {
+	out := make(map[string][]api.Issue)
+	for _, issue := range m.getFilteredIssues() {
+		if day, ok := m.calendarDate(issue); ok {
+			key := day.Format(dateLayout)
+			out[key] = append(out[key], issue)
+		}
+	}
+	return out
+}
+
+// openCalendar shows the month calendar, starting on today
+func (m *Model) openCalendar() tea.Cmd {
+	m.showModal = true
+	m.modalType = "calendar"
+	m.modalScroll = 0
+	m.calendarDay = today()
+	m.calendarList = false
+	m.calendarCursor = 0
+	if len(m.availableStatuses) == 0 {
+		return tea.Batch(appui.SendLoadingMsg("Fetching statuses..."), fetchStatuses(m.client))
+	}
+	return nil
+}
+
+// updateCalendar handles keys while the calendar is open. Enter lists the
+// selected day's issues; Enter on a listed issue jumps to it.
+func (m Model) updateCalendar(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.calendarList {
+		dayIssues := m.calendarIssues()[m.calendarDay.Format(dateLayout)]
+		switch msg.String() {
+		case "esc", "backspace":
+			m.calendarList = false
+		case "up", "k":
+			if m.calendarCursor > 0 {
+				m.calendarCursor--
+			}
+		case "down", "j":
+			if m.calendarCursor < len(dayIssues)-1 {
+				m.calendarCursor++
+			}
+		case "enter":
+			if m.calendarCursor >= len(dayIssues) {
+				return m, nil
+			}
+			issueID := dayIssues[m.calendarCursor].ID
+			for i, issue := range m.getFilteredIssues() {
+				if issue.ID == issueID {
+					m.showModal = false
+					m.modalType = ""
+					m.boardMode = false
+					m.discardTimelineShifts()
+					m.timelineMode = false
+					m.selectedIndex = i
+					m.updatePaneContent()
+					return m, m.loadDetail(issueID)
+				}
+			}
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q", "C":
+		m.showModal = false
+		m.modalType = ""
+	case "left", "h":
+		m.calendarDay = m.calendarDay.AddDate(0, 0, -1)
+	case "right", "l":
+		m.calendarDay = m.calendarDay.AddDate(0, 0, 1)
+	case "up", "k":
+		m.calendarDay = m.calendarDay.AddDate(0, 0, -7)
+	case "down", "j":
+		m.calendarDay = m.calendarDay.AddDate(0, 0, 7)
+	case "pgup", "[":
+		m.calendarDay = m.calendarDay.AddDate(0, -1, 0)
+	case "pgdown", "]":
+		m.calendarDay = m.calendarDay.AddDate(0, 1, 0)
+	case ".":
+		m.calendarDay = today()
+	case "s":
+		m.calendarUseStart = !m.calendarUseStart
+	case "enter":
+		if len(m.calendarIssues()[m.calendarDay.Format(dateLayout)]) > 0 {
+			m.calendarList = true
+			m.calendarCursor = 0
+		}
+	}
+	return m, nil
+}
+
+// renderCalendar renders the month of the selected day as a modal, with
+// the selected day's issues (or the day list) underneath
+func (m Model) renderCalendar() string {
+	headStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent)).Bold(true)
+	weekendStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	todayStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	selectedStyle := lipgloss.NewStyle().Background(lipgloss.Color(appui.Theme.Special)).Foreground(lipgloss.Color(appui.Theme.Title)).Bold(true).Reverse(appui.Theme.ReverseSelection)
+	countStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))
+	overdueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error)).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	cell := lipgloss.NewStyle().Width(calendarCellWidth)
+
+	byDay := m.calendarIssues()
+	now := today()
+
+	var head strings.Builder
+	for i, name := range []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"} {
+		style := headStyle
+		if i >= 5 {
+			style = weekendStyle
+		}
+		head.WriteString(cell.Render(style.Render(name)))
+	}
+	lines := []string{head.String()}
+
+	// Weeks start on Monday; step back from the 1st to the week's Monday
+	first := time.Date(m.calendarDay.Year(), m.calendarDay.Month(), 1, 0, 0, 0, 0, time.Local)
+	day := first.AddDate(0, 0, -((int(first.Weekday()) + 6) % 7))
+	for day.Month() == first.Month() || day.Before(first) {
+		var numbers, counts strings.Builder
+		for i := 0; i < 7; i++ {
+			if day.Month() != first.Month() {
+				numbers.WriteString(cell.Render(""))
+				counts.WriteString(cell.Render(""))
+				day = day.AddDate(0, 0, 1)
+				continue
+			}
+			label := fmt.Sprintf("%2d", day.Day())
+			switch {
+			case day.Equal(m.calendarDay):
+				label = selectedStyle.Render(" " + label + " ")
+			case day.Equal(now):
+				label = todayStyle.Render("[" + label + "]")
+			case i >= 5:
+				label = weekendStyle.Render(" " + label)
+			default:
+				label = " " + label
+			}
+			numbers.WriteString(cell.Render(label))
+
+			count := ""
+			if issues := byDay[day.Format(dateLayout)]; len(issues) > 0 {
+				count = countStyle.Render(fmt.Sprintf(" ●%d", len(issues)))
+				if !m.calendarUseStart && day.Before(now) {
+					for _, issue := range issues {
+						if !m.isClosedStatus(issue.Status.ID) {
+							count = overdueStyle.Render(fmt.Sprintf(" ●%d", len(issues)))
+							break
+						}
+					}
+				}
+			}
+			counts.WriteString(cell.Render(count))
+			day = day.AddDate(0, 0, 1)
+		}
+		lines = append(lines, numbers.String(), counts.String())
+	}
+
+	// The selected day's issues
+	dayIssues := byDay[m.calendarDay.Format(dateLayout)]
+	lines = append(lines, "", headStyle.Render(m.calendarDay.Format("Monday, January 2")))
+	if len(dayIssues) == 0 {
+		lines = append(lines, dimStyle.Render("No issues on this day."))
+	}
+	for i, issue := range dayIssues {
+		prefix := "  "
+		text := fmt.Sprintf("#%d %s", issue.ID, excerpt(issue.Subject, 44))
+		if m.calendarList && i == m.calendarCursor {
+			prefix = "→ "
+			text = cursorStyle.Render(text)
+		}
+		lines = append(lines, prefix+text+" "+dimStyle.Render(issue.Status.Name))
+	}
+
+	which := "due dates"
+	if m.calendarUseStart {
+		which = "start dates"
+	}
+	hint := "←→↑↓: Day  [ ]: Month  .: Today  s: Due/start  Enter: List day  Esc: Close"
+	if m.calendarList {
+		hint = "↑↓: Select  Enter: Open issue  Esc: Back to month"
+	}
+	lines = append(lines, "", dimStyle.Render(hint))
+
+	return appui.RenderModal(appui.ModalConfig{
+		Title:       fmt.Sprintf("%s · %s", m.calendarDay.Format("January 2006"), which),
+		Content:     lines,
+		Width:       m.width,
+		Height:      m.height,
+		BorderColor: appui.Theme.Accent,
+		TitleColor:  appui.Theme.Title,
+	})
+}
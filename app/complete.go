@@ -0,0 +1,241 @@
+package app
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// Inline completion for the note, quick-note and description editors:
+// "@" completes users (by login or name) into the "@login" mentions Redmine
+// expects, "#" completes issue IDs from the listed and recently viewed
+// issues. The token before the cursor is re-read after every key, so the
+// list follows typing, backspace and cursor moves.
+
+// completionLimit caps the suggestions shown below the editor
+const completionLimit = 6
+
+// completion is the state of the token being completed
+type completion struct {
+	trigger   rune   // '@' or '#'; 0 when no token is being completed
+	query     string // text typed after the trigger
+	row, col  int    // position of the trigger, to tell tokens apart
+	cursor    int    // selected suggestion
+	dismissed bool   // Esc closed the list for this token
+}
+
+// completionItem is one suggestion: the text inserted and how it is listed
+type completionItem struct {
+	insert string
+	label  string
+	detail string
+}
+
+// completionToken finds an "@" or "#" token ending at the textarea's cursor.
+// The trigger must start the line or follow a space or opening bracket, so
+// e-mail addresses and "issue#3" aren't completed.
+func completionToken(ta textarea.Model) (trigger rune, query string, row, col int, ok bool) {
+	row = ta.Line()
+	lines := strings.Split(ta.Value(), "\n")
+	if row >= len(lines) {
+		return 0, "", 0, 0, false
+	}
+	line := []rune(lines[row])
+	info := ta.LineInfo()
+	cursor := info.StartColumn + info.ColumnOffset
+	if cursor > len(line) {
+		cursor = len(line)
+	}
+	for i := cursor - 1; i >= 0; i-- {
+		r := line[i]
+		if r == '@' || r == '#' {
+			if i > 0 && !unicode.IsSpace(line[i-1]) && !strings.ContainsRune("([{", line[i-1]) {
+				return 0, "", 0, 0, false
+			}
+			return r, string(line[i+1 : cursor]), row, i, true
+		}
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("._-", r)) {
+			return 0, "", 0, 0, false
+		}
+	}
+	return 0, "", 0, 0, false
+}
+
+// refreshCompletion re-reads the token at the cursor after the textarea
+// changed. A new token resets the selection and a dismissed list; users
+// are fetched the first time a mention is started.
+func (m *Model) refreshCompletion(ta textarea.Model) tea.Cmd {
+	trigger, query, row, col, ok := completionToken(ta)
+	if !ok {
+		m.complete = completion{}
+		return nil
+	}
+	if trigger != m.complete.trigger || row != m.complete.row || col != m.complete.col {
+		m.complete = completion{trigger: trigger, row: row, col: col}
+	}
+	if query != m.complete.query {
+		m.complete.query = query
+		m.complete.cursor = 0
+	}
+	if trigger == '@' && len(m.availableUsers) == 0 && !m.lookupFetching[lookupKey("user", 0)] {
+		m.lookupFetching[lookupKey("user", 0)] = true
+		return tea.Batch(appui.SendLoadingMsg("Fetching users..."), fetchUsers(m.client))
+	}
+	return nil
+}
+
+// completionItems returns the suggestions for the current token, best
+// matches first: the exact login or ID, then prefixes, then the rest
+func (m *Model) completionItems() []completionItem {
+	if m.complete.trigger == 0 || m.complete.dismissed {
+		return nil
+	}
+	query := strings.ToLower(m.complete.query)
+	var exact, prefix, contains []completionItem
+	// keys[0] is the login or ID, the only key matched exactly
+	add := func(item completionItem, keys ...string) {
+		if strings.ToLower(keys[0]) == query {
+			exact = append(exact, item)
+			return
+		}
+		for _, k := range keys {
+			if strings.HasPrefix(strings.ToLower(k), query) {
+				prefix = append(prefix, item)
+				return
+			}
+		}
+		for _, k := range keys {
+			if strings.Contains(strings.ToLower(k), query) {
+				contains = append(contains, item)
+				return
+			}
+		}
+	}
+
+	switch m.complete.trigger {
+	case '@':
+		for _, u := range m.availableUsers {
+			if u.Login == "" {
+				continue // can't be mentioned
+			}
+			name := userDisplayName(u)
+			add(completionItem{insert: "@" + u.Login, label: "@" + u.Login, detail: name}, u.Login, name, u.Lastname)
+		}
+	case '#':
+		seen := make(map[int]bool)
+		for _, issue := range m.completionIssues() {
+			if seen[issue.id] {
+				continue
+			}
+			seen[issue.id] = true
+			id := strconv.Itoa(issue.id)
+			add(completionItem{insert: "#" + id, label: "#" + id, detail: issue.subject}, id, issue.subject)
+		}
+	}
+
+	items := append(append(exact, prefix...), contains...)
+	if len(items) > completionLimit {
+		items = items[:completionLimit]
+	}
+	return items
+}
+
+// completionIssue is an issue offered for "#" completion
+type completionIssue struct {
+	id      int
+	subject string
+}
+
+// completionIssues returns the listed issues and those viewed recently (the
+// detail cache), newest IDs first
+func (m *Model) completionIssues() []completionIssue {
+	var out []completionIssue
+	for _, issue := range m.issues {
+		out = append(out, completionIssue{issue.ID, issue.Subject})
+	}
+	for _, issue := range m.detailCache {
+		out = append(out, completionIssue{issue.ID, issue.Subject})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].id > out[j].id })
+	return out
+}
+
+// updateCompletion handles the keys that drive an open suggestion list:
+// ↑/↓ select, Tab/Enter insert, Esc closes the list. Enter on a suggestion
+// that is already typed out goes to the textarea, so a complete "#12"
+// still ends the line. It reports whether the key was used; other keys go
+// to the textarea.
+func (m *Model) updateCompletion(msg tea.KeyMsg, ta *textarea.Model) bool {
+	items := m.completionItems()
+	if len(items) == 0 {
+		return false
+	}
+	selected := items[min(m.complete.cursor, len(items)-1)]
+	if msg.String() == "enter" && strings.EqualFold(selected.insert, string(m.complete.trigger)+m.complete.query) {
+		m.complete = completion{}
+		return false
+	}
+	switch msg.String() {
+	case "up":
+		if m.complete.cursor > 0 {
+			m.complete.cursor--
+		}
+	case "down":
+		if m.complete.cursor < len(items)-1 {
+			m.complete.cursor++
+		}
+	case "tab", "enter":
+		// Replace the typed token, keeping the cursor where it is
+		for range []rune(m.complete.query) {
+			*ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		}
+		*ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		ta.InsertString(selected.insert + " ")
+		m.complete = completion{}
+	case "esc":
+		m.complete.dismissed = true
+	default:
+		return false
+	}
+	return true
+}
+
+// renderCompletion renders the suggestion list shown below an editor, or ""
+// when there is nothing to suggest
+func (m Model) renderCompletion() string {
+	items := m.completionItems()
+	if len(items) == 0 {
+		return ""
+	}
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent))
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+
+	var lines []string
+	for i, item := range items {
+		prefix := "  "
+		label := labelStyle.Render(item.label)
+		if i == m.complete.cursor {
+			prefix = "→ "
+			label = cursorStyle.Render(item.label)
+		}
+		lines = append(lines, prefix+label+" "+dimStyle.Render(excerpt(item.detail, 50-len(item.label))))
+	}
+	lines = append(lines, dimStyle.Render("↑↓: select   Tab/Enter: insert   Esc: close"))
+	return strings.Join(lines, "\n")
+}
+
+// withList appends a suggestion or picker list (if any) below an editor
+func withList(body, list string) string {
+	if list == "" {
+		return body
+	}
+	return body + "\n" + list
+}
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+)
+
+const (
+	detailDebounce      = 150 * time.Millisecond // wait for navigation to settle before fetching
+	prefetchRadius      = 2                      // issues prefetched on each side of the selection
+	prefetchConcurrency = 3                      // max prefetch requests in flight
+	detailCacheLimit    = 200                    // cache size above which unlisted issues are evicted
+)
+
+// detailDebounceMsg fires once navigation has paused on an issue. It is
+// ignored unless seq still matches the model's latest detail request.
+type detailDebounceMsg struct {
+	issueID int
+	seq     int
+}
+
+// detailPrefetchedMsg carries a neighbor's details fetched in the background
+type detailPrefetchedMsg struct {
+	issueID int
+	issue   *api.Issue
+	err     error
+}
+
+// cachedDetail returns the cached full details for an issue if they are
+// still current, i.e. the cached updated_on matches the one in the list.
+func (m *Model) cachedDetail(issue api.Issue) (api.Issue, bool) {
+	cached, ok := m.detailCache[issue.ID]
+	if !ok || !cached.UpdatedOn.Equal(issue.UpdatedOn) {
+		return api.Issue{}, false
+	}
+	return cached, true
+}
+
+// cacheDetail stores an issue's full details. Once the cache grows past
+// detailCacheLimit, the details of issues no longer listed are dropped.
+func (m *Model) cacheDetail(issue api.Issue) {
+	m.detailCache[issue.ID] = issue
+	if len(m.detailCache) <= detailCacheLimit {
+		return
+	}
+	listed := make(map[int]bool, len(m.issues))
+	for _, listedIssue := range m.issues {
+		listed[listedIssue.ID] = true
+	}
+	for id := range m.detailCache {
+		if !listed[id] && id != issue.ID {
+			delete(m.detailCache, id)
+		}
+	}
+}
+
+// applyCachedDetail swaps a fresh cached copy into the issue list.
+// It reports whether the cache could serve the issue.
+func (m *Model) applyCachedDetail(issueID int) bool {
+	for i, issue := range m.issues {
+		if issue.ID != issueID {
+			continue
+		}
+		cached, ok := m.cachedDetail(issue)
+		if !ok {
+			return false
+		}
+		m.issues[i] = cached
+		if m.ready {
+			m.updatePaneContent()
+		}
+		return true
+	}
+	return false
+}
+
+// loadDetail shows full details for the newly selected issue: straight from
+// the cache when possible, otherwise via a fetch that is debounced so rapid
+// navigation doesn't fire a request per keypress.
+func (m *Model) loadDetail(issueID int) tea.Cmd {
+	if m.detailCancel != nil {
+		m.detailCancel()
+		m.detailCancel = nil
+	}
+	if m.prefetchCancel != nil {
+		// The old neighbors' prefetches are no longer wanted
+		m.prefetchCancel()
+		m.prefetchCancel = nil
+		m.prefetching = make(map[int]bool)
+	}
+	m.detailSeq++
+	m.markSeen(issueID)
+	if m.applyCachedDetail(issueID) {
+		return m.prefetchNeighbors()
+	}
+	seq := m.detailSeq
+	return tea.Tick(detailDebounce, func(time.Time) tea.Msg {
+		return detailDebounceMsg{issueID: issueID, seq: seq}
+	})
+}
+
+// prefetchNeighbors fetches details for the issues around the selection in
+// the background, skipping ones already cached or in flight. The requests
+// share a context that is cancelled once the selection moves.
+func (m *Model) prefetchNeighbors() tea.Cmd {
+	if m.prefetchCancel == nil {
+		m.prefetchCtx, m.prefetchCancel = context.WithCancel(context.Background())
+	}
+	filteredIssues := m.getFilteredIssues()
+	var cmds []tea.Cmd
+	for d := 1; d <= prefetchRadius; d++ {
+		for _, idx := range []int{m.selectedIndex + d, m.selectedIndex - d} {
+			if idx < 0 || idx >= len(filteredIssues) {
+				continue
+			}
+			issue := filteredIssues[idx]
+			if _, ok := m.cachedDetail(issue); ok || m.prefetching[issue.ID] {
+				continue
+			}
+			m.prefetching[issue.ID] = true
+			cmds = append(cmds, prefetchDetail(m.prefetchCtx, m.prefetchSem, m.client, issue.ID))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// prefetchDetail fetches an issue's details, holding a slot in sem for the
+// duration of the request to bound concurrency.
+func prefetchDetail(ctx context.Context, sem chan struct{}, client *api.Client, issueID int) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return detailPrefetchedMsg{issueID: issueID, err: ctx.Err()}
+		}
+		defer func() { <-sem }()
+		issue, err := client.GetIssueContext(ctx, issueID)
+		return detailPrefetchedMsg{issueID: issueID, issue: issue, err: err}
+	}
+}
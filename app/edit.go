@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
 )
 
 // EditableField represents a field that can be edited
@@ -18,6 +20,7 @@ type EditableField struct {
 	Type        string // "text", "number", "select", "date", "multiline"
 	GetValue    func(*api.Issue) string
 	GetOptions  func(*Model) []string // for select fields
+	ErrorLabels []string              // Redmine attribute labels used in validation messages
 }
 
 // Define editable fields
@@ -27,18 +30,21 @@ var editableFields = []EditableField{
 		DisplayName: "Subject",
 		Type:        "text",
 		GetValue:    func(i *api.Issue) string { return i.Subject },
+		ErrorLabels: []string{"Subject"},
 	},
 	{
 		Name:        "description",
 		DisplayName: "Description",
 		Type:        "multiline",
 		GetValue:    func(i *api.Issue) string { return i.Description },
+		ErrorLabels: []string{"Description"},
 	},
 	{
 		Name:        "status_id",
 		DisplayName: "Status",
 		Type:        "select",
 		GetValue:    func(i *api.Issue) string { return i.Status.Name },
+		ErrorLabels: []string{"Status"},
 		GetOptions: func(m *Model) []string {
 			options := []string{}
 			for _, s := range m.availableStatuses {
@@ -52,6 +58,7 @@ var editableFields = []EditableField{
 		DisplayName: "Priority",
 		Type:        "select",
 		GetValue:    func(i *api.Issue) string { return i.Priority.Name },
+		ErrorLabels: []string{"Priority"},
 		GetOptions: func(m *Model) []string {
 			options := []string{}
 			for _, p := range m.availablePriorities {
@@ -60,6 +67,34 @@ var editableFields = []EditableField{
 			return options
 		},
 	},
+	{
+		Name:        "project_id",
+		DisplayName: "Project",
+		Type:        "select",
+		GetValue:    func(i *api.Issue) string { return i.Project.Name },
+		ErrorLabels: []string{"Project"},
+		GetOptions: func(m *Model) []string {
+			options := []string{}
+			for _, p := range m.availableProjects {
+				options = append(options, p.Name)
+			}
+			return options
+		},
+	},
+	{
+		Name:        "tracker_id",
+		DisplayName: "Tracker",
+		Type:        "select",
+		GetValue:    func(i *api.Issue) string { return i.Tracker.Name },
+		ErrorLabels: []string{"Tracker"},
+		GetOptions: func(m *Model) []string {
+			options := []string{}
+			for _, t := range m.editTrackers() {
+				options = append(options, t.Name)
+			}
+			return options
+		},
+	},
 	{
 		Name:        "assigned_to_id",
 		DisplayName: "Assigned To",
@@ -70,6 +105,7 @@ var editableFields = []EditableField{
 			}
 			return "Unassigned"
 		},
+		ErrorLabels: []string{"Assignee", "Assigned to"},
 		GetOptions: func(m *Model) []string {
 			options := []string{"Unassigned"}
 			for _, u := range m.availableUsers {
@@ -86,20 +122,310 @@ var editableFields = []EditableField{
 			return options
 		},
 	},
+	{
+		Name:        "category_id",
+		DisplayName: "Category",
+		Type:        "select",
+		GetValue: func(i *api.Issue) string {
+			if i.Category != nil {
+				return i.Category.Name
+			}
+			return noCategory
+		},
+		ErrorLabels: []string{"Category"},
+		GetOptions: func(m *Model) []string {
+			options := []string{noCategory}
+			for _, c := range m.projectCategories[m.editProjectID()] {
+				options = append(options, c.Name)
+			}
+			return options
+		},
+	},
+	{
+		Name:        "fixed_version_id",
+		DisplayName: "Target Version",
+		Type:        "select",
+		GetValue: func(i *api.Issue) string {
+			if i.FixedVersion != nil {
+				return i.FixedVersion.Name
+			}
+			return noVersion
+		},
+		ErrorLabels: []string{"Target version"},
+		GetOptions: func(m *Model) []string {
+			options := []string{noVersion}
+			issue := m.editingIssue()
+			if issue == nil {
+				return options
+			}
+			for _, v := range m.projectVersions[m.editProjectID()] {
+				// Closed versions can't take new issues, but keep the current one
+				if v.Status != "closed" || (issue.FixedVersion != nil && issue.FixedVersion.ID == v.ID) {
+					options = append(options, v.Name)
+				}
+			}
+			return options
+		},
+	},
 	{
 		Name:        "done_ratio",
 		DisplayName: "Progress",
 		Type:        "number",
 		GetValue:    func(i *api.Issue) string { return fmt.Sprintf("%d", i.DoneRatio) },
+		ErrorLabels: []string{"% Done"},
+	},
+	{
+		Name:        "estimated_hours",
+		DisplayName: "Estimated Hours",
+		Type:        "number",
+		GetValue: func(i *api.Issue) string {
+			if i.EstimatedHours == nil {
+				return ""
+			}
+			return formatHours(*i.EstimatedHours)
+		},
+		ErrorLabels: []string{"Estimated time"},
+	},
+	{
+		Name:        "start_date",
+		DisplayName: "Start Date",
+		Type:        "date",
+		GetValue:    func(i *api.Issue) string { return i.StartDate },
+		ErrorLabels: []string{"Start date"},
 	},
 	{
 		Name:        "due_date",
 		DisplayName: "Due Date",
 		Type:        "date",
 		GetValue:    func(i *api.Issue) string { return i.DueDate },
+		ErrorLabels: []string{"Due date"},
 	},
 }
 
+// noVersion and noCategory are the select options for an issue without a
+// target version or category
+const (
+	noVersion  = "(none)"
+	noCategory = "(none)"
+)
+
+// editingIssue returns the issue being edited (the selected issue), if any
+func (m *Model) editingIssue() *api.Issue {
+	filteredIssues := m.getFilteredIssues()
+	if m.selectedIndex < 0 || m.selectedIndex >= len(filteredIssues) {
+		return nil
+	}
+	return &filteredIssues[m.selectedIndex]
+}
+
+// formatHours renders an hour count without trailing zeros, e.g. "1.5"
+func formatHours(h float64) string {
+	return strconv.FormatFloat(h, 'f', -1, 64)
+}
+
+// overBudget returns how many hours an issue's spent time exceeds its
+// estimate by, using the totals with subtasks where the server sends them
+// (0 if within the estimate or not estimated)
+func overBudget(issue api.Issue) float64 {
+	estimate := issue.EstimatedHours
+	if issue.TotalEstimatedHours != nil {
+		estimate = issue.TotalEstimatedHours
+	}
+	spent := issue.SpentHours
+	if issue.TotalSpentHours > 0 {
+		spent = issue.TotalSpentHours
+	}
+	if estimate == nil || *estimate <= 0 || spent <= *estimate {
+		return 0
+	}
+	return spent - *estimate
+}
+
+// validateEditValue checks a number field's input before it is queued,
+// returning a message to show next to the field ("" if the value is fine)
+func validateEditValue(field, value string) string {
+	switch field {
+	case "done_ratio":
+		if ratio, err := strconv.Atoi(value); err != nil || ratio < 0 || ratio > 100 {
+			return "must be a whole number from 0 to 100"
+		}
+	case "estimated_hours":
+		if value == "" {
+			return "" // clears the estimate
+		}
+		if h, err := strconv.ParseFloat(value, 64); err != nil || h < 0 {
+			return "must be a number of hours, e.g. 4 or 1.5"
+		}
+	}
+	return ""
+}
+
+// rejectEditValue validates the edited input of the current field. An
+// invalid value is reported next to the field and keeps it focused; a valid
+// one clears any earlier report.
+func (m *Model) rejectEditValue(field EditableField) bool {
+	if field.Type != "number" || !m.editedFields[field.Name] {
+		return false
+	}
+	msg := validateEditValue(field.Name, strings.TrimSpace(m.editInput.Value()))
+	if msg == "" {
+		delete(m.saveErrors, field.Name)
+		return false
+	}
+	if m.saveErrors == nil {
+		m.saveErrors = make(map[string]string)
+	}
+	m.saveErrors[field.Name] = field.DisplayName + " " + msg
+	return true
+}
+
+// editProjectID returns the project the edited issue will be in: the
+// pending project if one was picked, otherwise the issue's own
+func (m *Model) editProjectID() int {
+	if name, ok := m.pendingEdits["project_id"]; ok {
+		for _, p := range m.availableProjects {
+			if p.Name == name {
+				return p.ID
+			}
+		}
+	}
+	if issue := m.editingIssue(); issue != nil {
+		return issue.Project.ID
+	}
+	return 0
+}
+
+// editTrackers returns the trackers the edited issue can use: those enabled
+// in its project once loaded, otherwise all trackers
+func (m *Model) editTrackers() []api.Tracker {
+	if trackers, ok := m.projectTrackers[m.editProjectID()]; ok {
+		return trackers
+	}
+	return m.availableTrackers
+}
+
+// projectFieldsWaiting starts the message shown when a project move is
+// saved before the new project's option lists have loaded
+const projectFieldsWaiting = "Waiting for the new project's "
+
+// projectFieldList is one of the option lists the project-dependent fields
+// (trackers, categories, versions) are checked against
+type projectFieldList struct {
+	key    string // lookupFetching key, "kind:projectID"
+	label  string
+	loaded bool
+	fetch  tea.Cmd
+}
+
+func (m *Model) projectFieldLists(projectID int) []projectFieldList {
+	_, trackersLoaded := m.projectTrackers[projectID]
+	_, categoriesLoaded := m.projectCategories[projectID]
+	_, versionsLoaded := m.projectVersions[projectID]
+	return []projectFieldList{
+		{lookupKey("trackers", 0), "trackers", len(m.availableTrackers) > 0, fetchTrackers(m.client)},
+		{lookupKey("project-trackers", projectID), "project trackers", trackersLoaded, fetchProjectTrackers(m.client, projectID)},
+		{lookupKey("categories", projectID), "categories", categoriesLoaded, fetchCategories(m.client, projectID)},
+		{lookupKey("versions", projectID), "versions", versionsLoaded, fetchVersions(m.client, projectID)},
+	}
+}
+
+// projectFieldsPending returns the option lists of a project that are
+// still awaited: neither loaded nor failed
+func (m *Model) projectFieldsPending(projectID int) []string {
+	var pending []string
+	for _, list := range m.projectFieldLists(projectID) {
+		if _, failed := m.projectFieldErrors[list.key]; !list.loaded && !failed {
+			pending = append(pending, list.label)
+		}
+	}
+	return pending
+}
+
+// projectFieldCmds fetches the option lists of the project-dependent fields
+// that aren't loaded for a project yet. Lists already requested or that
+// failed to load aren't requested again.
+func (m *Model) projectFieldCmds(projectID int) []tea.Cmd {
+	var cmds []tea.Cmd
+	for _, list := range m.projectFieldLists(projectID) {
+		if _, failed := m.projectFieldErrors[list.key]; list.loaded || failed || m.lookupFetching[list.key] {
+			continue
+		}
+		m.lookupFetching[list.key] = true
+		cmds = append(cmds, appui.SendLoadingMsg("Fetching "+list.label+"..."), list.fetch)
+	}
+	return cmds
+}
+
+// projectFieldLoaded settles a requested option list. A failed list is
+// remembered so a project move can still be saved, with its field left
+// unchecked, and the error is shown while editing.
+func (m *Model) projectFieldLoaded(kind string, projectID int, label string, err error) tea.Cmd {
+	key := lookupKey(kind, projectID)
+	delete(m.lookupFetching, key)
+	if err != nil {
+		m.projectFieldErrors[key] = err.Error()
+		if m.editMode {
+			if m.saveErrors == nil {
+				m.saveErrors = make(map[string]string)
+			}
+			m.saveErrors[""] = fmt.Sprintf("Couldn't load the %s (%v), they won't be checked before saving", label, err)
+		}
+	}
+	return appui.SendLoadingCompleteMsg()
+}
+
+// revalidateProjectFields re-checks the tracker, category and version once
+// the project is changed in edit mode. Values the new project doesn't offer
+// are reset (the tracker to the project's first one, the others to none),
+// and option lists not loaded yet are fetched; it runs again when they
+// arrive.
+func (m *Model) revalidateProjectFields() tea.Cmd {
+	if !m.editMode {
+		return nil
+	}
+	if _, ok := m.pendingEdits["project_id"]; !ok {
+		return nil
+	}
+	projectID := m.editProjectID()
+	_, categoriesLoaded := m.projectCategories[projectID]
+	_, versionsLoaded := m.projectVersions[projectID]
+	loaded := map[string]bool{
+		"tracker_id":       len(m.editTrackers()) > 0,
+		"category_id":      categoriesLoaded,
+		"fixed_version_id": versionsLoaded,
+	}
+	for _, field := range editableFields {
+		if !loaded[field.Name] {
+			continue
+		}
+		value, ok := m.pendingEdits[field.Name]
+		if !ok {
+			value = m.originalValues[field.Name]
+		}
+		options := field.GetOptions(m)
+		valid := false
+		for _, opt := range options {
+			if opt == value {
+				valid = true
+				break
+			}
+		}
+		if valid || len(options) == 0 {
+			continue
+		}
+		m.pendingEdits[field.Name] = options[0]
+		m.editedFields[field.Name] = true
+		if editableFields[m.editFieldIndex].Name == field.Name {
+			m.editInput.SetValue(options[0])
+		}
+	}
+	m.hasUnsavedChanges = len(m.pendingEdits) > 0
+	if strings.HasPrefix(m.saveErrors[""], projectFieldsWaiting) && len(m.projectFieldsPending(projectID)) == 0 {
+		delete(m.saveErrors, "")
+	}
+	return tea.Batch(m.projectFieldCmds(projectID)...)
+}
+
 // Message types for edit operations
 type statusesLoadedMsg struct {
 	statuses []api.Status
@@ -111,8 +437,28 @@ type prioritiesLoadedMsg struct {
 	err        error
 }
 
+type trackersLoadedMsg struct {
+	trackers []api.Tracker
+	err      error
+}
+
+type projectTrackersLoadedMsg struct {
+	projectID int
+	trackers  []api.Tracker
+	err       error
+}
+
+type categoriesLoadedMsg struct {
+	projectID  int
+	categories []api.IssueCategory
+	err        error
+}
+
 type issueUpdatedMsg struct {
 	issueID int
+	change  *changeRecord // previous values, logged for undo on success
+	undoOf  int           // sequence number of the change this reverts
+	local   bool          // the change is already shown, so the list needn't be reloaded
 	err     error
 }
 
@@ -131,30 +477,57 @@ func fetchPriorities(client *api.Client) tea.Cmd {
 	}
 }
 
+func fetchTrackers(client *api.Client) tea.Cmd {
+	return func() tea.Msg {
+		trackers, err := client.GetTrackers()
+		return trackersLoadedMsg{trackers: trackers, err: err}
+	}
+}
+
+func fetchProjectTrackers(client *api.Client, projectID int) tea.Cmd {
+	return func() tea.Msg {
+		trackers, err := client.GetProjectTrackers(projectID)
+		return projectTrackersLoadedMsg{projectID: projectID, trackers: trackers, err: err}
+	}
+}
+
+func fetchCategories(client *api.Client, projectID int) tea.Cmd {
+	return func() tea.Msg {
+		categories, err := client.GetIssueCategories(projectID)
+		return categoriesLoadedMsg{projectID: projectID, categories: categories, err: err}
+	}
+}
+
 // addNote posts a note/comment to an issue. Redmine records this as a new
-// journal entry via the standard issue update endpoint.
-func addNote(client *api.Client, issueID int, note string) tea.Cmd {
+// journal entry via the standard issue update endpoint; private notes are
+// only shown to users allowed to see them.
+func addNote(client *api.Client, issueID int, note string, private bool) tea.Cmd {
+	updates := map[string]interface{}{"notes": note}
+	if private {
+		updates["private_notes"] = true
+	}
 	return func() tea.Msg {
-		err := client.UpdateIssue(issueID, map[string]interface{}{"notes": note})
+		err := client.UpdateIssue(issueID, updates)
 		return issueUpdatedMsg{issueID: issueID, err: err}
 	}
 }
 
 // updateIssueStatus changes just the status of an issue (used by the quick
-// status picker).
-func updateIssueStatus(client *api.Client, issueID, statusID int) tea.Cmd {
+// status picker). change holds the previous values for undo.
+func updateIssueStatus(client *api.Client, issueID, statusID int, change *changeRecord) tea.Cmd {
 	return func() tea.Msg {
 		err := client.UpdateIssue(issueID, map[string]interface{}{"status_id": statusID})
-		return issueUpdatedMsg{issueID: issueID, err: err}
+		return issueUpdatedMsg{issueID: issueID, change: change, err: err}
 	}
 }
 
 // updateIssueFields applies an arbitrary set of field updates in one request
 // (used by the quick-actions popup to change status/assignee/note together).
-func updateIssueFields(client *api.Client, issueID int, updates map[string]interface{}) tea.Cmd {
+// change holds the previous values for undo.
+func updateIssueFields(client *api.Client, issueID int, updates map[string]interface{}, change *changeRecord) tea.Cmd {
 	return func() tea.Msg {
 		err := client.UpdateIssue(issueID, updates)
-		return issueUpdatedMsg{issueID: issueID, err: err}
+		return issueUpdatedMsg{issueID: issueID, change: change, err: err}
 	}
 }
 
@@ -170,72 +543,160 @@ func userDisplayName(u api.User) string {
 	return u.Login
 }
 
-// updateIssueMultiple sends all pending edits to the API in one request
+// saveFieldErrors maps a failed save onto the editable fields it refers to,
+// using each field's ErrorLabels to attribute Redmine's validation messages.
+// Messages that match no field (and non-validation errors) are collected
+// under the "" key so they can still be shown.
+func saveFieldErrors(err error) map[string]string {
+	out := make(map[string]string)
+	add := func(name, msg string) {
+		if prev, ok := out[name]; ok {
+			out[name] = prev + "; " + msg
+		} else {
+			out[name] = msg
+		}
+	}
+
+	var verr *api.ValidationError
+	if !errors.As(err, &verr) {
+		add("", err.Error())
+		return out
+	}
+
+	for _, msg := range verr.Messages {
+		matched := ""
+		for _, field := range editableFields {
+			for _, label := range field.ErrorLabels {
+				if strings.HasPrefix(strings.ToLower(msg), strings.ToLower(label)+" ") {
+					matched = field.Name
+					break
+				}
+			}
+			if matched != "" {
+				break
+			}
+		}
+		add(matched, msg)
+	}
+	return out
+}
+
+// updateIssueMultiple sends all pending edits to the API in one request,
+// recording the previous values for undo.
 func updateIssueMultiple(client *api.Client, issueID int, pendingEdits map[string]string, m Model) tea.Cmd {
+	updates := pendingUpdates(pendingEdits, m)
+	change := m.recordChange(issueID, updates)
 	return func() tea.Msg {
-		updates := make(map[string]interface{})
+		err := client.UpdateIssue(issueID, updates)
+		return issueUpdatedMsg{issueID: issueID, change: change, err: err}
+	}
+}
 
-		for fieldName, value := range pendingEdits {
-			switch fieldName {
-			case "subject":
-				if value != "" {
-					updates["subject"] = value
+// pendingUpdates converts the edit session's display values into API fields
+func pendingUpdates(pendingEdits map[string]string, m Model) map[string]interface{} {
+	updates := make(map[string]interface{})
+
+	for fieldName, value := range pendingEdits {
+		switch fieldName {
+		case "subject":
+			if value != "" {
+				updates["subject"] = value
+			}
+		case "description":
+			updates["description"] = value
+		case "status_id":
+			// Find status ID by name
+			for _, s := range m.availableStatuses {
+				if s.Name == value {
+					updates["status_id"] = s.ID
+					break
+				}
+			}
+		case "priority_id":
+			// Find priority ID by name
+			for _, p := range m.availablePriorities {
+				if p.Name == value {
+					updates["priority_id"] = p.ID
+					break
 				}
-			case "description":
-				updates["description"] = value
-			case "status_id":
-				// Find status ID by name
-				for _, s := range m.availableStatuses {
-					if s.Name == value {
-						updates["status_id"] = s.ID
+			}
+		case "assigned_to_id":
+			if value == "Unassigned" {
+				updates["assigned_to_id"] = nil
+			} else {
+				// Find user ID by name
+				for _, u := range m.availableUsers {
+					displayName := u.Name
+					if displayName == "" {
+						if u.Firstname != "" || u.Lastname != "" {
+							displayName = strings.TrimSpace(u.Firstname + " " + u.Lastname)
+						} else if u.Login != "" {
+							displayName = u.Login
+						}
+					}
+					if displayName == value {
+						updates["assigned_to_id"] = u.ID
 						break
 					}
 				}
-			case "priority_id":
-				// Find priority ID by name
-				for _, p := range m.availablePriorities {
-					if p.Name == value {
-						updates["priority_id"] = p.ID
+			}
+		case "project_id":
+			for _, p := range m.availableProjects {
+				if p.Name == value {
+					updates["project_id"] = p.ID
+					break
+				}
+			}
+		case "tracker_id":
+			for _, t := range m.editTrackers() {
+				if t.Name == value {
+					updates["tracker_id"] = t.ID
+					break
+				}
+			}
+		case "category_id":
+			if value == noCategory {
+				updates["category_id"] = nil
+			} else {
+				for _, c := range m.projectCategories[m.editProjectID()] {
+					if c.Name == value {
+						updates["category_id"] = c.ID
 						break
 					}
 				}
-			case "assigned_to_id":
-				if value == "Unassigned" {
-					updates["assigned_to_id"] = nil
-				} else {
-					// Find user ID by name
-					for _, u := range m.availableUsers {
-						displayName := u.Name
-						if displayName == "" {
-							if u.Firstname != "" || u.Lastname != "" {
-								displayName = strings.TrimSpace(u.Firstname + " " + u.Lastname)
-							} else if u.Login != "" {
-								displayName = u.Login
-							}
-						}
-						if displayName == value {
-							updates["assigned_to_id"] = u.ID
-							break
-						}
+			}
+		case "fixed_version_id":
+			if value == noVersion {
+				updates["fixed_version_id"] = nil
+			} else {
+				for _, v := range m.projectVersions[m.editProjectID()] {
+					if v.Name == value {
+						updates["fixed_version_id"] = v.ID
+						break
 					}
 				}
-			case "done_ratio":
-				ratio, err := strconv.Atoi(value)
-				if err == nil && ratio >= 0 && ratio <= 100 {
-					updates["done_ratio"] = ratio
-				}
-			case "due_date":
-				if value != "" {
-					updates["due_date"] = value
-				} else {
-					updates["due_date"] = nil
-				}
+			}
+		case "done_ratio":
+			ratio, err := strconv.Atoi(value)
+			if err == nil && ratio >= 0 && ratio <= 100 {
+				updates["done_ratio"] = ratio
+			}
+		case "estimated_hours":
+			value = strings.TrimSpace(value)
+			if value == "" {
+				updates["estimated_hours"] = nil
+			} else if h, err := strconv.ParseFloat(value, 64); err == nil && h >= 0 {
+				updates["estimated_hours"] = h
+			}
+		case "start_date", "due_date":
+			if value != "" {
+				updates[fieldName] = value
+			} else {
+				updates[fieldName] = nil
 			}
 		}
-
-		err := client.UpdateIssue(issueID, updates)
-		return issueUpdatedMsg{issueID: issueID, err: err}
 	}
+	return updates
 }
 
 // renderEditFooter renders the footer when in edit mode
@@ -246,11 +707,11 @@ func (m Model) renderEditFooter() string {
 
 	field := editableFields[m.editFieldIndex]
 	style := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#61AFEF")).
+		Foreground(lipgloss.Color(appui.Theme.Accent)).
 		Bold(true)
 
 	promptStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#98C379"))
+		Foreground(lipgloss.Color(appui.Theme.Success))
 
 	var footer string
 
@@ -258,10 +719,10 @@ func (m Model) renderEditFooter() string {
 	footer += style.Render("EDIT MODE") + " "
 	unsavedIndicator := ""
 	if m.hasUnsavedChanges {
-		unsavedIndicator = lipgloss.NewStyle().Foreground(lipgloss.Color("#E06C75")).Render(" [UNSAVED] ")
+		unsavedIndicator = lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error)).Render(" [UNSAVED] ")
 	}
 	footer += unsavedIndicator
-	footer += lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render("Tab/Enter: Next | ↑↓: Select | Ctrl+S: Save | Esc: Cancel") + "\n"
+	footer += lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted)).Render("Tab/Enter: Next | ↑↓: Select | Ctrl+S: Save | Esc: Cancel") + "\n"
 
 	// Show current field being edited
 	footer += promptStyle.Render(fmt.Sprintf("Editing %s: ", field.DisplayName))
@@ -269,12 +730,12 @@ func (m Model) renderEditFooter() string {
 	// Show input or selection options
 	if field.Type == "select" {
 		options := field.GetOptions(&m)
-		footer += lipgloss.NewStyle().Foreground(lipgloss.Color("#E5C07B")).Render(m.editInput.Value()) + " "
-		footer += lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render(
+		footer += lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning)).Render(m.editInput.Value()) + " "
+		footer += lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted)).Render(
 			fmt.Sprintf("[%d options]", len(options)),
 		)
 	} else if field.Type == "multiline" {
-		footer += lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render("[press Enter to open the editor]")
+		footer += lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted)).Render("[press Enter to open the editor]")
 	} else {
 		footer += m.editInput.View()
 	}
@@ -285,7 +746,8 @@ func (m Model) renderEditFooter() string {
 // getFieldHighlightStyle returns style for highlighting the selected field
 func getFieldHighlightStyle() lipgloss.Style {
 	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#000000")).
-		Background(lipgloss.Color("#61AFEF")).
-		Bold(true)
+		Foreground(lipgloss.Color(appui.Theme.OnAccent)).
+		Background(lipgloss.Color(appui.Theme.Accent)).
+		Bold(true).
+		Reverse(appui.Theme.ReverseSelection)
 }
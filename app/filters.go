@@ -8,7 +8,7 @@ import (
 )
 
 // assigneeOption is a selectable assignee in the quick-actions popup.
-// ID 0 represents "Unassigned".
+// ID 0 represents "Unassigned" and ID -1 "(no change)" (bulk mode only).
 type assigneeOption struct {
 	ID   int
 	Name string
@@ -19,6 +19,9 @@ type assigneeOption struct {
 // type-to-filter text.
 func (m *Model) quickFilteredAssignees() []assigneeOption {
 	opts := []assigneeOption{{ID: 0, Name: "Unassigned"}}
+	if len(m.quickBulkIDs) > 0 {
+		opts = append([]assigneeOption{{ID: -1, Name: "(no change)"}}, opts...)
+	}
 	for _, u := range m.availableUsers {
 		opts = append(opts, assigneeOption{ID: u.ID, Name: userDisplayName(u)})
 	}
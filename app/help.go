@@ -1,35 +1,70 @@
 package app
 
 import (
+	"github.com/charmbracelet/bubbles/key"
+
 	appui "github.com/ktsopanakis/redmine-tui/ui"
 )
 
-// getHelpContent returns the help modal content
+// getHelpContent returns the help modal content. The list view's keys come
+// from the key map, so rebinding them in config.yaml shows up here.
 func (m Model) getHelpContent() []string {
-	return []string{
-		"Navigation:",
-		"  ↑/k, ↓/j       - Move up/down in lists",
-		"  PgUp/PgDn      - Page up/down",
-		"  Tab            - Switch between panes",
-		"  Home/End       - Go to first/last item",
+	var lines []string
+	for _, group := range helpGroups {
+		lines = append(lines, group+":")
+		for _, a := range m.keys.actions() {
+			if a.group != group || !a.binding.Enabled() {
+				continue
+			}
+			lines = append(lines, helpLine(a.binding.Help().Key, a.desc))
+			for _, more := range a.more {
+				lines = append(lines, helpLine("", more))
+			}
+		}
+		lines = append(lines, helpFixed[group]...)
+		lines = append(lines, "")
+	}
+
+	keys := func(b key.Binding) string { return b.Help().Key }
+	lines = append(lines,
+		"Board ("+keys(m.keys.Board)+"):",
+		"  ←→↑↓/hjkl      - Move between columns and cards",
+		"  H/L            - Move the card to the previous/next column",
+		"  g              - Group columns by status/assignee/priority/tracker/project",
+		"  Enter          - Open the card in the list/details view",
+		helpLine(keys(m.keys.Board)+"/Esc", "Back to the list"),
+		"",
+		"Timeline ("+keys(m.keys.Timeline)+"):",
+		"  ↑↓/jk          - Select an issue",
+		"  ←→/hl, PgUp/Dn - Scroll the axis   . - Today   g - Selected issue",
+		"  w              - Toggle day/week scale",
+		"  H/L            - Shift start and due date by a day",
+		"  [ ] / < >      - Shift only the start / due date",
+		"  Ctrl+S         - Save shifted dates   Esc - Discard them",
+		"  Enter          - Open the issue in the list/details view",
 		"",
-		"Filtering & Views:",
-		"  f              - Toggle filter mode (filter issues by text)",
-		"  m              - Toggle between My Issues/All Issues",
-		"  r              - Reload all issues from server",
-		"  u              - Select users to filter by",
-		"  p              - Select projects to filter by",
+		"Calendar ("+keys(m.keys.Calendar)+"):",
+		"  ←→↑↓/hjkl      - Move by day/week   [ ] / PgUp/PgDn - Previous/next month",
+		"  .              - Today   s - Toggle due/start dates",
+		"  Enter          - List the day's issues (Enter again opens one)",
 		"",
-		"Issue Management:",
-		"  a              - Quick actions popup (status + assignee + note)",
-		"  s              - Quick-change the status of the selected issue",
-		"  c              - Add a note/comment to the selected issue",
-		"  e              - Enter edit mode (modify issue fields)",
-		"  Enter          - When editing: save changes",
-		"  Space          - When in selection list: toggle item",
+		"Roadmap ("+keys(m.keys.Roadmap)+"):",
+		"  ↑↓/jk          - Select a version (progress, open/closed counts, due date)",
+		"  Enter          - List the version's issues (Enter again opens one)",
+		"  c              - Show/hide closed versions   r - Reload counts",
+		"",
+		"History ("+keys(m.keys.History)+"):",
+		"  ↑↓/jk, n/p     - Jump between entries   g/G - First/last",
+		"  f              - All / notes only / field changes only",
+		"  u              - Cycle through the entries' authors",
+		"  PgUp/PgDn      - Scroll within a long entry (description diffs)",
+		"  e / d          - Edit / delete your own selected note (d asks to confirm)",
+		"  r              - Reply to the selected note, quoting it",
 		"",
 		"Edit Mode:",
-		"  ↑/k, ↓/j       - Change value of a select field (Status, etc.)",
+		"  ↑/k, ↓/j       - Change value of a select field (Status, Project, etc.)",
+		"                   Changing the project resets its tracker, category and version",
+		"                   if the new project doesn't offer them",
 		"  Tab            - Move to next field",
 		"  Enter          - Next field (or open editor for Description)",
 		"  Ctrl+S         - Save all changes",
@@ -38,17 +73,16 @@ func (m Model) getHelpContent() []string {
 		"  Enter          - Insert a new line",
 		"  Ctrl+S         - Apply text   Esc - Cancel",
 		"",
-		"General:",
-		"  ?              - Show this help",
-		"  Esc            - Cancel/close current action",
-		"  q              - Quit application",
-		"",
 		"Tips:",
 		"  - Selected users/projects appear at the top of lists",
 		"  - Use filter in selection lists to quickly find items",
 		"  - Unsaved changes show a red border on the details pane",
+		"  - With refresh.interval set, issues updated in the background are marked ●",
 		"  - Press Esc to discard changes in edit mode",
-	}
+		"  - Keys of the list view can be rebound in the keys section of config.yaml,",
+		"    e.g. \"reload: ctrl+r\" (see the README for the action names)",
+	)
+	return lines
 }
 
 // renderHelpModal renders the help modal
@@ -58,8 +92,8 @@ func (m Model) renderHelpModal() string {
 		Content:      m.getHelpContent(),
 		Width:        m.width,
 		Height:       m.height,
-		BorderColor:  "#61AFEF",
-		TitleColor:   "#FFFFFF",
+		BorderColor:  appui.Theme.Accent,
+		TitleColor:   appui.Theme.Title,
 		ScrollOffset: m.modalScroll,
 	}
 	return appui.RenderModal(cfg)
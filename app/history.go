@@ -0,0 +1,515 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// historyKinds are the journal filters cycled with "f"
+var historyKinds = []string{"all", "notes", "changes"}
+
+// historyWrapWidth is the text width inside the (fixed-width) modal
+const historyWrapWidth = 58
+
+// journalAttrLabels names issue attributes that have no undoable field
+var journalAttrLabels = map[string]string{
+	"parent_id":  "Parent task",
+	"is_private": "Private",
+	"author_id":  "Author",
+}
+
+// journalDetailText resolves a journal detail to a human label and display
+// values: IDs become names and custom fields get their names from the issue.
+func (m *Model) journalDetailText(issue api.Issue, d api.JournalDetail) (label, oldText, newText string) {
+	switch d.Property {
+	case "cf":
+		label = "Custom field #" + d.Name
+		for _, cf := range issue.CustomFields {
+			if strconv.Itoa(cf.ID) == d.Name {
+				label = cf.Name
+				break
+			}
+		}
+		return label, d.OldValue, d.NewValue
+	case "attachment":
+		return "File", d.OldValue, d.NewValue
+	case "relation":
+		label = "Relation"
+		if d.Name != "" {
+			label = strings.ReplaceAll(d.Name, "_", " ")
+			label = strings.ToUpper(label[:1]) + label[1:]
+		}
+		if d.OldValue != "" {
+			oldText = "#" + d.OldValue
+		}
+		if d.NewValue != "" {
+			newText = "#" + d.NewValue
+		}
+		return label, oldText, newText
+	}
+
+	// Older servers name some attributes without the _id suffix
+	name := d.Name
+	if fieldLabel(name+"_id") != name+"_id" {
+		name += "_id"
+	}
+	label = fieldLabel(name)
+	if l, ok := journalAttrLabels[name]; ok {
+		label = l
+	}
+	resolve := func(value string) string {
+		switch {
+		case value == "" && name == "assigned_to_id":
+			return "(unassigned)"
+		case value == "" || name == "description" || name == "subject":
+			return value
+		case name == "parent_id":
+			return "#" + value
+		}
+		return m.updateValueText(name, value)
+	}
+	return label, resolve(d.OldValue), resolve(d.NewValue)
+}
+
+// diffLine is one line of a line diff: op is ' ', '+' or '-'
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// maxDiffCells caps the size of lineDiff's LCS table (old × new lines
+// after the common head and tail are set aside). Bigger changes are shown
+// as the old lines removed and the new ones added.
+const maxDiffCells = 1 << 20
+
+// lineDiff compares two texts line by line (longest common subsequence),
+// returning unchanged, removed and added lines in order
+func lineDiff(oldText, newText string) []diffLine {
+	a := strings.Split(strings.ReplaceAll(oldText, "\r\n", "\n"), "\n")
+	b := strings.Split(strings.ReplaceAll(newText, "\r\n", "\n"), "\n")
+
+	// Unchanged leading and trailing lines need no table
+	var head, tail []diffLine
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		head = append(head, diffLine{' ', a[0]})
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		tail = append([]diffLine{{' ', a[len(a)-1]}}, tail...)
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	out := head
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, line := range a {
+			out = append(out, diffLine{'-', line})
+		}
+		for _, line := range b {
+			out = append(out, diffLine{'+', line})
+		}
+		return append(out, tail...)
+	}
+
+	// lcs[i][j] is the common length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, diffLine{'-', a[i]})
+			i++
+		default:
+			out = append(out, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		out = append(out, diffLine{'+', b[j]})
+	}
+	return append(out, tail...)
+}
+
+// diffStat counts the added and removed lines of a diff
+func diffStat(diff []diffLine) (added, removed int) {
+	for _, l := range diff {
+		switch l.op {
+		case '+':
+			added++
+		case '-':
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// descriptionDiffStat returns the added and removed line counts of a
+// journal's description change, computed once per journal
+func (m *Model) descriptionDiffStat(journalID int, d api.JournalDetail) (added, removed int) {
+	if counts, ok := m.diffStats[journalID]; ok {
+		return counts[0], counts[1]
+	}
+	added, removed = diffStat(lineDiff(d.OldValue, d.NewValue))
+	m.diffStats[journalID] = [2]int{added, removed}
+	return added, removed
+}
+
+// journalUpdatedMsg reports the result of editing or deleting a note
+type journalUpdatedMsg struct {
+	issueID   int
+	journalID int
+	deleted   bool
+	err       error
+}
+
+// updateJournal replaces a journal's notes; empty notes delete the note
+func updateJournal(client *api.Client, issueID, journalID int, notes string, private bool) tea.Cmd {
+	return func() tea.Msg {
+		err := client.UpdateJournal(journalID, notes, private)
+		return journalUpdatedMsg{issueID: issueID, journalID: journalID, deleted: notes == "", err: err}
+	}
+}
+
+// ownNote reports whether a journal entry is a note by the current user,
+// which they may edit or delete (if the server allows it)
+func (m *Model) ownNote(j api.Journal) bool {
+	return j.Notes != "" && m.currentUser != nil && j.User.ID == m.currentUser.ID
+}
+
+// editHistoryNote opens the note editor on the selected entry's notes. The
+// history view reopens when the editor closes.
+func (m *Model) editHistoryNote(j api.Journal) tea.Cmd {
+	m.showModal = false
+	m.modalType = ""
+	m.noteMode = true
+	m.noteIssueID = m.historyIssueID
+	m.noteJournal = j.ID
+	m.noteUpdates = nil
+	m.notePrivate = j.PrivateNotes
+	m.noteInput.SetValue(j.Notes)
+	return m.noteInput.Focus()
+}
+
+// reopenHistory shows the history view again after editing a note, keeping
+// the filters and selection
+func (m *Model) reopenHistory() {
+	m.showModal = true
+	m.modalType = "history"
+	m.modalScroll = 0
+	if entries := m.historyEntries(); m.historyCursor >= len(entries) {
+		m.historyCursor = len(entries) - 1
+	}
+}
+
+// historyIssue returns the issue whose history is shown
+func (m *Model) historyIssue() *api.Issue {
+	for i := range m.issues {
+		if m.issues[i].ID == m.historyIssueID {
+			return &m.issues[i]
+		}
+	}
+	return nil
+}
+
+// historyEntries returns the journals of the history issue that pass the
+// type and user filters, oldest first
+func (m *Model) historyEntries() []api.Journal {
+	issue := m.historyIssue()
+	if issue == nil {
+		return nil
+	}
+	var out []api.Journal
+	for _, j := range issue.Journals {
+		if m.historyKind == "notes" && j.Notes == "" {
+			continue
+		}
+		if m.historyKind == "changes" && len(j.Details) == 0 {
+			continue
+		}
+		if m.historyUser != 0 && j.User.ID != m.historyUser {
+			continue
+		}
+		out = append(out, j)
+	}
+	return out
+}
+
+// historyUsers returns the authors of the history issue's journals, in
+// order of first appearance
+func (m *Model) historyUsers() []api.User {
+	issue := m.historyIssue()
+	if issue == nil {
+		return nil
+	}
+	var users []api.User
+	seen := make(map[int]bool)
+	for _, j := range issue.Journals {
+		if !seen[j.User.ID] {
+			seen[j.User.ID] = true
+			users = append(users, j.User)
+		}
+	}
+	return users
+}
+
+// openHistory shows the selected issue's history, newest entry selected.
+// The details (with journals) are fetched if the list entry lacks them.
+func (m *Model) openHistory() tea.Cmd {
+	issue := m.editingIssue()
+	if issue == nil {
+		return nil
+	}
+	m.showModal = true
+	m.modalType = "history"
+	m.modalScroll = 0
+	m.historyIssueID = issue.ID
+	m.historyKind = "all"
+	m.historyUser = 0
+	m.historyDelete = 0
+	m.historyNotice = ""
+	m.historyCursor = len(m.historyEntries()) - 1
+	if len(issue.Journals) == 0 {
+		return m.fetchSelectedDetail(issue.ID)
+	}
+	return nil
+}
+
+// updateHistory handles keys while the history is open: j/k jump between
+// entries, f and u cycle the type and user filters, e and d edit or delete
+// the selected note if it is the user's own.
+func (m Model) updateHistory(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	entries := m.historyEntries()
+	var selected *api.Journal
+	if m.historyCursor >= 0 && m.historyCursor < len(entries) {
+		selected = &entries[m.historyCursor]
+	}
+
+	// A pending delete takes y to confirm; anything else cancels it
+	if m.historyDelete != 0 {
+		journalID := m.historyDelete
+		m.historyDelete = 0
+		if msg.String() == "y" {
+			m.historyNotice = ""
+			m.loading = true
+			return m, tea.Batch(
+				appui.SendLoadingMsg("Deleting note..."),
+				updateJournal(m.client, m.historyIssueID, journalID, "", false),
+			)
+		}
+		m.historyNotice = ""
+		return m, nil
+	}
+
+	m.historyNotice = ""
+	switch msg.String() {
+	case "e":
+		if selected != nil && m.ownNote(*selected) {
+			return m, m.editHistoryNote(*selected)
+		}
+		m.historyNotice = "Only your own notes can be edited"
+	case "d":
+		if selected != nil && m.ownNote(*selected) {
+			m.historyDelete = selected.ID
+		} else {
+			m.historyNotice = "Only your own notes can be deleted"
+		}
+	case "r":
+		if selected != nil && selected.Notes != "" {
+			return m, m.replyTo(m.historyIssueID, *selected)
+		}
+		m.historyNotice = "Only notes can be quoted"
+	case "esc", "q", "H":
+		m.showModal = false
+		m.modalType = ""
+		return m, nil
+	case "down", "j", "n":
+		if m.historyCursor < len(entries)-1 {
+			m.historyCursor++
+		}
+		m.modalScroll = 0
+	case "up", "k", "p":
+		if m.historyCursor > 0 {
+			m.historyCursor--
+		}
+		m.modalScroll = 0
+	case "home", "g":
+		m.historyCursor = 0
+		m.modalScroll = 0
+	case "end", "G":
+		m.historyCursor = len(entries) - 1
+		m.modalScroll = 0
+	case "pgdown", " ":
+		m.modalScroll += 10
+	case "pgup":
+		if m.modalScroll -= 10; m.modalScroll < 0 {
+			m.modalScroll = 0
+		}
+	case "f":
+		for i, kind := range historyKinds {
+			if kind == m.historyKind {
+				m.historyKind = historyKinds[(i+1)%len(historyKinds)]
+				break
+			}
+		}
+		m.historyCursor = len(m.historyEntries()) - 1
+		m.modalScroll = 0
+	case "u":
+		// Cycle everyone → each journal author → everyone
+		users := m.historyUsers()
+		next := 0
+		for i, u := range users {
+			if u.ID == m.historyUser {
+				if i+1 < len(users) {
+					next = users[i+1].ID
+				}
+				break
+			}
+		}
+		if m.historyUser == 0 && len(users) > 0 {
+			next = users[0].ID
+		}
+		m.historyUser = next
+		m.historyCursor = len(m.historyEntries()) - 1
+		m.modalScroll = 0
+	}
+	return m, nil
+}
+
+// renderHistory renders the filtered journals as a modal, scrolled to the
+// selected entry
+func (m Model) renderHistory() string {
+	userStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Special)).Bold(true)
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	fieldStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent)).Bold(true)
+	oldValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning))
+	newValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))
+	removedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error))
+	privateStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error)).Bold(true)
+	wrap := lipgloss.NewStyle().Width(historyWrapWidth)
+
+	issue := m.historyIssue()
+	entries := m.historyEntries()
+	var lines []string
+	start := 0
+	if issue == nil {
+		lines = append(lines, dimStyle.Render("The issue is no longer in the list."))
+	} else if len(issue.Journals) == 0 {
+		lines = append(lines, dimStyle.Render("No history available."))
+	} else if len(entries) == 0 {
+		lines = append(lines, dimStyle.Render("No entries match the filters."))
+	}
+
+	for i, j := range entries {
+		if i == m.historyCursor {
+			start = len(lines)
+		}
+		head := userStyle.Render(j.User.Name)
+		prefix := "  "
+		if i == m.historyCursor {
+			prefix = "→ "
+			head = cursorStyle.Render(j.User.Name)
+		}
+		head = prefix + head + " " + dimStyle.Render(j.CreatedOn.Local().Format("2006-01-02 15:04"))
+		if j.PrivateNotes {
+			head += " " + privateStyle.Render("[private]")
+		}
+		lines = append(lines, head)
+
+		for _, d := range j.Details {
+			label, oldText, newText := m.journalDetailText(*issue, d)
+			if d.Property == "attr" && d.Name == "description" {
+				diff := lineDiff(d.OldValue, d.NewValue)
+				added, removed := diffStat(diff)
+				lines = append(lines, "    "+fieldStyle.Render(label+":")+" "+
+					dimStyle.Render(fmt.Sprintf("+%d −%d lines", added, removed)))
+				for _, l := range diff {
+					text := excerpt(l.text, historyWrapWidth-6)
+					switch l.op {
+					case '+':
+						lines = append(lines, "    "+newValueStyle.Render("+ "+text))
+					case '-':
+						lines = append(lines, "    "+removedStyle.Render("- "+text))
+					default:
+						lines = append(lines, "    "+dimStyle.Render("  "+text))
+					}
+				}
+				continue
+			}
+			line := fieldStyle.Render(label+":") + " "
+			switch {
+			case d.OldValue != "" && d.NewValue != "":
+				line += oldValueStyle.Render(oldText) + " → " + newValueStyle.Render(newText)
+			case d.NewValue != "":
+				line += newValueStyle.Render(newText)
+			default:
+				line += oldValueStyle.Render(oldText) + " → " + newValueStyle.Render("(removed)")
+			}
+			for _, l := range strings.Split(wrap.Render(line), "\n") {
+				lines = append(lines, "    "+l)
+			}
+		}
+		if j.Notes != "" {
+			for _, l := range strings.Split(wrap.Render(j.Notes), "\n") {
+				lines = append(lines, "    "+l)
+			}
+		}
+		lines = append(lines, "")
+	}
+
+	// Confirmation and results of note edits go first, in view
+	switch {
+	case m.historyDelete != 0:
+		lines = append(lines[:start:start], append([]string{
+			privateStyle.Render(fmt.Sprintf("Delete your note #%d? y: delete, any other key: keep", m.historyDelete)), "",
+		}, lines[start:]...)...)
+	case m.historyNotice != "":
+		lines = append(lines[:start:start], append([]string{cursorStyle.Render(m.historyNotice), ""}, lines[start:]...)...)
+	}
+
+	who := "everyone"
+	if m.historyUser != 0 {
+		for _, u := range m.historyUsers() {
+			if u.ID == m.historyUser {
+				who = u.Name
+			}
+		}
+	}
+	return appui.RenderModal(appui.ModalConfig{
+		Title: fmt.Sprintf("History of #%d · %s · %s · %d/%d (f/u: filter, Esc: close)",
+			m.historyIssueID, m.historyKind, who, m.historyCursor+1, len(entries)),
+		Content:      lines,
+		Width:        m.width,
+		Height:       m.height,
+		BorderColor:  appui.Theme.Warning,
+		TitleColor:   appui.Theme.Title,
+		ScrollOffset: start + m.modalScroll,
+	})
+}
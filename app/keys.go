@@ -0,0 +1,354 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/ktsopanakis/redmine-tui/config"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// keyMap holds the key bindings of the list view. Each one can be overridden
+// in the "keys" section of config.yaml under its action name (see actions).
+// Keys local to a mode or dialog (board, timeline, modals, editors) are fixed.
+type keyMap struct {
+	Up, Down, PageUp, PageDown, Home, End, SwitchPane key.Binding
+
+	Filter, ToggleMine, Reload, Users, Projects, Notifications, Palette key.Binding
+	Board, Timeline, Calendar, Roadmap                                  key.Binding
+
+	Actions, Status, Note, PrevNote, NextNote, Reply, Edit key.Binding
+	Undo, ChangeLog, History, Copy, Browser                key.Binding
+
+	Mark, MarkRange, MarkAll key.Binding
+
+	Help, Quit key.Binding
+}
+
+// keyAction describes a binding for the config file and the help
+type keyAction struct {
+	name    string   // action name in config.yaml
+	group   string   // help section
+	desc    string   // help text
+	more    []string // further help lines
+	binding *key.Binding
+}
+
+// helpGroups are the help sections generated from the key map, in order
+var helpGroups = []string{"Navigation", "Filtering & Views", "Issue Management", "Bulk Operations", "General"}
+
+// helpFixed lists keys that can't be rebound, shown after a section's bindings
+var helpFixed = map[string][]string{
+	"Issue Management": {
+		helpLine("Enter", "When editing: save changes"),
+		helpLine("Space", "When in selection list: toggle item"),
+	},
+	"Bulk Operations": {
+		helpLine("Esc", "Clear marks"),
+	},
+	"General": {
+		helpLine("Esc", "Cancel/close current action"),
+	},
+}
+
+// reservedKeys are handled before the key map and can't be bound
+var reservedKeys = map[string]string{
+	"enter":  "opens the issue",
+	"esc":    "cancels",
+	"ctrl+c": "quits",
+	"ctrl+s": "saves",
+}
+
+// newBinding creates a binding whose help shows its keys
+func newBinding(short string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(keyNames(keys), short))
+}
+
+// defaultKeyMap returns the built-in bindings
+func defaultKeyMap() keyMap {
+	return keyMap{
+		Up:         newBinding("Up", "up", "k"),
+		Down:       newBinding("Down", "down", "j"),
+		PageUp:     newBinding("Page up", "pgup", "b"),
+		PageDown:   newBinding("Page down", "pgdown"),
+		Home:       newBinding("First", "home"),
+		End:        newBinding("Last", "end"),
+		SwitchPane: newBinding("Switch", "tab"),
+
+		Filter:        newBinding("Filter", "f"),
+		ToggleMine:    newBinding("My/All", "m"),
+		Reload:        newBinding("Reload", "r"),
+		Users:         newBinding("Users", "u"),
+		Projects:      newBinding("Projects", "p"),
+		Notifications: newBinding("Notifications", "n"),
+		Palette:       newBinding("Commands", ":", "ctrl+p"),
+		Board:         newBinding("Board", "B"),
+		Timeline:      newBinding("Timeline", "t"),
+		Calendar:      newBinding("Calendar", "C"),
+		Roadmap:       newBinding("Roadmap", "v"),
+
+		Actions:   newBinding("Actions", "a"),
+		Status:    newBinding("Status", "s"),
+		Note:      newBinding("Note", "c"),
+		PrevNote:  newBinding("Previous note", "["),
+		NextNote:  newBinding("Next note", "]"),
+		Reply:     newBinding("Reply", "R"),
+		Edit:      newBinding("Edit", "e"),
+		Undo:      newBinding("Undo", "z"),
+		ChangeLog: newBinding("Changes", "Z"),
+		History:   newBinding("History", "H"),
+		Copy:      newBinding("Copy", "y"),
+		Browser:   newBinding("Browser", "o"),
+
+		Mark:      newBinding("Mark", " "),
+		MarkRange: newBinding("Mark range", "V"),
+		MarkAll:   newBinding("Mark all", "*"),
+
+		Help: newBinding("Help", "?"),
+		Quit: newBinding("Quit", "q"),
+	}
+}
+
+// actions lists every binding with its config name, in help order
+func (k *keyMap) actions() []keyAction {
+	return []keyAction{
+		{"up", "Navigation", "Move up in lists", nil, &k.Up},
+		{"down", "Navigation", "Move down in lists", nil, &k.Down},
+		{"page_up", "Navigation", "Page up", nil, &k.PageUp},
+		{"page_down", "Navigation", "Page down", nil, &k.PageDown},
+		{"home", "Navigation", "Go to the first issue (top of the details)", nil, &k.Home},
+		{"end", "Navigation", "Go to the last issue (end of the details)", nil, &k.End},
+		{"switch_pane", "Navigation", "Switch between panes", nil, &k.SwitchPane},
+
+		{"filter", "Filtering & Views", "Toggle filter mode (filter issues by text)", nil, &k.Filter},
+		{"toggle_mine", "Filtering & Views", "Toggle between My Issues/All Issues", nil, &k.ToggleMine},
+		{"reload", "Filtering & Views", "Reload all issues from server", nil, &k.Reload},
+		{"users", "Filtering & Views", "Select users to filter by", nil, &k.Users},
+		{"projects", "Filtering & Views", "Select projects to filter by", nil, &k.Projects},
+		{"notifications", "Filtering & Views", "Notification center (assignments, updates, mentions)", nil, &k.Notifications},
+		{"palette", "Filtering & Views", "Command palette (fuzzy search; e.g. goto 1234, assign @bob,",
+			[]string{"status Resolved)"}, &k.Palette},
+		{"board", "Filtering & Views", "Board view", nil, &k.Board},
+		{"timeline", "Filtering & Views", "Timeline of start and due dates", nil, &k.Timeline},
+		{"calendar", "Filtering & Views", "Calendar of due dates", nil, &k.Calendar},
+		{"roadmap", "Filtering & Views", "Roadmap of versions", nil, &k.Roadmap},
+
+		{"actions", "Issue Management", "Quick actions popup (status + assignee + note)", nil, &k.Actions},
+		{"status", "Issue Management", "Quick-change the status of the selected issue", nil, &k.Status},
+		{"note", "Issue Management", "Add a note/comment to the selected issue", []string{
+			"(Ctrl+O in the note or quick actions: private note)",
+			"(Ctrl+R there: insert a note template, see templates.yaml)",
+			"(@ / # in notes and descriptions: complete users / issues)",
+		}, &k.Note},
+		{"prev_note", "Issue Management", "Select the previous note in the details pane", nil, &k.PrevNote},
+		{"next_note", "Issue Management", "Select the next note in the details pane", nil, &k.NextNote},
+		{"reply", "Issue Management", "Reply to the selected (or latest) note, quoting it", nil, &k.Reply},
+		{"edit", "Issue Management", "Enter edit mode (modify issue fields)", nil, &k.Edit},
+		{"undo", "Issue Management", "Undo the last change made this session", nil, &k.Undo},
+		{"change_log", "Issue Management", "Change log (pick a change to undo)", nil, &k.ChangeLog},
+		{"history", "Issue Management", "History of the selected issue (filters, description diffs)", nil, &k.History},
+		{"copy", "Issue Management", "Copy the issue URL, #ID, \"#ID: Subject\" or a Markdown link", nil, &k.Copy},
+		{"browser", "Issue Management", "Open the issue, its project or the current query in the browser", nil, &k.Browser},
+
+		{"mark", "Bulk Operations", "Mark/unmark the selected issue", []string{
+			"(with marked issues, quick actions set status/assignee/priority/",
+			"version/note on all of them)",
+		}, &k.Mark},
+		{"mark_range", "Bulk Operations", "Mark all issues from the last marked one to the cursor", nil, &k.MarkRange},
+		{"mark_all", "Bulk Operations", "Mark all listed issues (again to clear)", nil, &k.MarkAll},
+
+		{"help", "General", "Show this help", nil, &k.Help},
+		{"quit", "General", "Quit application", nil, &k.Quit},
+	}
+}
+
+// binding returns the binding of an action by its config name
+func (k *keyMap) binding(name string) *key.Binding {
+	for _, a := range k.actions() {
+		if a.name == name {
+			return a.binding
+		}
+	}
+	return nil
+}
+
+// footer lists the bindings shown in the footer, in order, and whether each
+// is kept when the footer is too narrow for all of them
+func (k *keyMap) footer() []appui.FooterItem {
+	entries := []struct {
+		binding  key.Binding
+		required bool
+	}{
+		{k.SwitchPane, false}, {k.Filter, true}, {k.ToggleMine, true}, {k.Reload, true},
+		{k.Actions, true}, {k.Edit, true}, {k.Status, true}, {k.Note, true}, {k.Reply, false},
+		{k.Mark, false}, {k.Notifications, false}, {k.Undo, false}, {k.Board, false},
+		{k.Timeline, false}, {k.Calendar, false}, {k.Roadmap, false}, {k.History, false},
+		{k.Copy, false}, {k.Browser, false}, {k.Palette, false}, {k.Help, false}, {k.Quit, true},
+	}
+	var items []appui.FooterItem
+	if k.Up.Enabled() && k.Down.Enabled() {
+		items = append(items, appui.FooterItem{Text: k.Up.Help().Key + " " + k.Down.Help().Key + ": Nav"})
+	}
+	for _, e := range entries {
+		if e.binding.Enabled() {
+			items = append(items, appui.FooterItem{Text: keyName(e.binding.Keys()[0]) + ": " + e.binding.Help().Desc, Required: e.required})
+		}
+	}
+	return items
+}
+
+// newKeyMap applies the config's overrides to the default bindings. It
+// returns the problems found: unknown actions or keys, keys bound to more
+// than one action, and reserved keys.
+func newKeyMap(overrides map[string]config.KeyList) (keyMap, []string) {
+	k := defaultKeyMap()
+	var problems []string
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b := k.binding(name)
+		if b == nil {
+			problems = append(problems, fmt.Sprintf("keys.%s: unknown action", name))
+			continue
+		}
+		var keys []string
+		for _, s := range overrides[name] {
+			if norm, ok := normalizeKey(s); ok {
+				keys = append(keys, norm)
+			} else {
+				problems = append(problems, fmt.Sprintf("keys.%s: unknown key %q", name, s))
+			}
+		}
+		b.SetKeys(keys...)
+		b.SetHelp(keyNames(keys), b.Help().Desc)
+		b.SetEnabled(len(keys) > 0)
+	}
+
+	owners := make(map[string][]string)
+	var order []string
+	for _, a := range k.actions() {
+		for _, s := range a.binding.Keys() {
+			if why, ok := reservedKeys[s]; ok {
+				problems = append(problems, fmt.Sprintf("%s: %s is reserved (it %s)", a.name, keyName(s), why))
+				continue
+			}
+			if len(owners[s]) == 0 {
+				order = append(order, s)
+			}
+			owners[s] = append(owners[s], a.name)
+		}
+	}
+	for _, s := range order {
+		if len(owners[s]) > 1 {
+			problems = append(problems, fmt.Sprintf("%s is bound to %s", keyName(s), strings.Join(owners[s], ", ")))
+		}
+	}
+	return k, problems
+}
+
+// namedKeys maps the key names bubbletea reports to what the help shows
+var namedKeys = map[string]string{
+	"up": "↑", "down": "↓", "left": "←", "right": "→",
+	"pgup": "PgUp", "pgdown": "PgDn", "home": "Home", "end": "End",
+	"tab": "Tab", " ": "Space", "enter": "Enter", "esc": "Esc",
+	"backspace": "Backspace", "delete": "Del", "insert": "Ins",
+}
+
+// keyName returns how the help shows a key, e.g. "↑" or "Ctrl+P"
+func keyName(s string) string {
+	if name, ok := namedKeys[s]; ok {
+		return name
+	}
+	if rest, ok := strings.CutPrefix(s, "ctrl+"); ok {
+		return "Ctrl+" + strings.ToUpper(rest)
+	}
+	if rest, ok := strings.CutPrefix(s, "alt+"); ok {
+		return "Alt+" + keyName(rest)
+	}
+	if len([]rune(s)) > 1 {
+		return strings.ToUpper(s[:1]) + s[1:] // f1 -> F1, shift+tab -> Shift+tab
+	}
+	return s
+}
+
+// keyNames joins the help names of keys, e.g. "↑/k"
+func keyNames(keys []string) string {
+	names := make([]string, len(keys))
+	for i, s := range keys {
+		names[i] = keyName(s)
+	}
+	return strings.Join(names, "/")
+}
+
+// keyAliases are friendlier spellings accepted in the config file
+var keyAliases = map[string]string{
+	"space": " ", "pageup": "pgup", "pagedown": "pgdown", "pgdn": "pgdown",
+	"escape": "esc", "return": "enter", "del": "delete",
+}
+
+// normalizeKey turns a key from the config file into the name bubbletea
+// reports for it. Single characters are case-sensitive; names such as
+// "Ctrl+R" or "PgUp" are not. ok is false if there is no such key.
+func normalizeKey(s string) (string, bool) {
+	if len([]rune(s)) == 1 {
+		return s, true
+	}
+	s = strings.ToLower(strings.TrimSpace(s))
+	if alias, ok := keyAliases[s]; ok {
+		s = alias
+	}
+	base := strings.TrimPrefix(s, "alt+")
+	if _, ok := keyType(base); ok || len([]rune(base)) == 1 {
+		return s, true
+	}
+	return "", false
+}
+
+// keyType finds the key type bubbletea reports under a name, e.g. "pgup"
+func keyType(name string) (tea.KeyType, bool) {
+	for t := tea.KeyType(-128); t < 128; t++ {
+		if t != tea.KeyRunes && t.String() == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// keyMsg builds the message a key press sends, for running bindings
+func keyMsg(s string) tea.KeyMsg {
+	base, alt := strings.CutPrefix(s, "alt+")
+	if t, ok := keyType(base); ok {
+		return tea.KeyMsg{Type: t, Alt: alt}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(base), Alt: alt}
+}
+
+// helpLine formats a help entry; an empty key continues the previous one
+func helpLine(keys, desc string) string {
+	if keys == "" {
+		return strings.Repeat(" ", 19) + desc
+	}
+	return fmt.Sprintf("  %-14s - %s", keys, desc)
+}
+
+// renderKeyProblems renders the key binding problems found at startup
+func (m Model) renderKeyProblems() string {
+	lines := append([]string{"Problems with the keys section of config.yaml:", ""}, m.keyProblems...)
+	return appui.RenderModal(appui.ModalConfig{
+		Title:        "Key bindings (Esc: close)",
+		Content:      lines,
+		Width:        m.width,
+		Height:       m.height,
+		BorderColor:  appui.Theme.Error,
+		TitleColor:   appui.Theme.Title,
+		ScrollOffset: m.modalScroll,
+	})
+}
@@ -92,14 +92,14 @@ func (m Model) renderListOverlay() string {
 	switch m.userInputMode {
 	case "user":
 		title = "Select Users (↑/↓: Navigate, Space: Toggle, Enter: Apply, Esc: Cancel)"
-		borderColor = "#61AFEF"
+		borderColor = appui.Theme.Accent
 		loadingMsg = "Loading users..."
 		emptyMsg = "No users found"
 		mutableModel := m
 		items = mutableModel.buildUserListItems()
 	case "project":
 		title = "Select Projects (↑/↓: Navigate, Space: Toggle, Enter: Apply, Esc: Cancel)"
-		borderColor = "#98C379"
+		borderColor = appui.Theme.Success
 		loadingMsg = "Loading projects..."
 		emptyMsg = "No projects found"
 		mutableModel := m
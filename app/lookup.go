@@ -0,0 +1,251 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// Name lookups. Views show IDs (from journals, updates, the change log...)
+// through lookupName, which checks the loaded lists first - users,
+// statuses, trackers, priorities, projects, versions, categories - and then
+// a cache of names seen on issues or fetched one at a time. resolveNames
+// fills the gaps for an issue, e.g. locked users that aren't in the user
+// list or versions shared from projects that weren't loaded.
+
+// lookupFields maps update/journal attribute names to lookup kinds
+var lookupFields = map[string]string{
+	"assigned_to_id":   "user",
+	"author_id":        "user",
+	"status_id":        "status",
+	"tracker_id":       "tracker",
+	"priority_id":      "priority",
+	"project_id":       "project",
+	"fixed_version_id": "version",
+	"category_id":      "category",
+}
+
+// lookupFallbacks label IDs that couldn't be resolved, e.g. "User #7"
+var lookupFallbacks = map[string]string{
+	"user":     "User",
+	"status":   "Status",
+	"tracker":  "Tracker",
+	"priority": "Priority",
+	"project":  "Project",
+	"version":  "Version",
+	"category": "Category",
+}
+
+// lookupLoadedMsg carries a name fetched for a single ID
+type lookupLoadedMsg struct {
+	kind string
+	id   int
+	name string
+	err  error
+}
+
+func lookupKey(kind string, id int) string {
+	return fmt.Sprintf("%s:%d", kind, id)
+}
+
+// lookupName returns the display name of an ID of the given kind, or a
+// placeholder like "Tracker #7" while it is unknown
+func (m *Model) lookupName(kind, idText string) string {
+	if idText == "" {
+		return ""
+	}
+	id, err := strconv.Atoi(idText)
+	if err != nil {
+		return idText
+	}
+	if name, ok := m.listedName(kind, id); ok {
+		return name
+	}
+	if name, ok := m.lookupCache[lookupKey(kind, id)]; ok {
+		return name
+	}
+	return lookupFallbacks[kind] + " #" + idText
+}
+
+// listedName looks an ID up in the lists loaded for pickers and views
+func (m *Model) listedName(kind string, id int) (string, bool) {
+	switch kind {
+	case "user":
+		for _, u := range m.availableUsers {
+			if u.ID == id {
+				return userDisplayName(u), true
+			}
+		}
+	case "status":
+		for _, s := range m.availableStatuses {
+			if s.ID == id {
+				return s.Name, true
+			}
+		}
+	case "tracker":
+		for _, t := range m.availableTrackers {
+			if t.ID == id {
+				return t.Name, true
+			}
+		}
+	case "priority":
+		for _, p := range m.availablePriorities {
+			if p.ID == id {
+				return p.Name, true
+			}
+		}
+	case "project":
+		for _, p := range m.availableProjects {
+			if p.ID == id {
+				return p.Name, true
+			}
+		}
+	case "version":
+		for _, versions := range m.projectVersions {
+			for _, v := range versions {
+				if v.ID == id {
+					return v.Name, true
+				}
+			}
+		}
+	case "category":
+		for _, categories := range m.projectCategories {
+			for _, c := range categories {
+				if c.ID == id {
+					return c.Name, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// known reports whether an ID resolves to a name
+func (m *Model) known(kind string, id int) bool {
+	if _, ok := m.listedName(kind, id); ok {
+		return true
+	}
+	_, ok := m.lookupCache[lookupKey(kind, id)]
+	return ok
+}
+
+// seedLookups caches the names that issues carry alongside their IDs
+func (m *Model) seedLookups(issues ...api.Issue) {
+	seed := func(kind string, id int, name string) {
+		if id != 0 && name != "" {
+			m.lookupCache[lookupKey(kind, id)] = name
+		}
+	}
+	for _, issue := range issues {
+		seed("project", issue.Project.ID, issue.Project.Name)
+		seed("tracker", issue.Tracker.ID, issue.Tracker.Name)
+		seed("status", issue.Status.ID, issue.Status.Name)
+		seed("priority", issue.Priority.ID, issue.Priority.Name)
+		seed("user", issue.Author.ID, issue.Author.Name)
+		if issue.AssignedTo != nil {
+			seed("user", issue.AssignedTo.ID, issue.AssignedTo.Name)
+		}
+		if issue.FixedVersion != nil {
+			seed("version", issue.FixedVersion.ID, issue.FixedVersion.Name)
+		}
+		if issue.Category != nil {
+			seed("category", issue.Category.ID, issue.Category.Name)
+		}
+		for _, j := range issue.Journals {
+			seed("user", j.User.ID, j.User.Name)
+		}
+	}
+}
+
+// resolveNames makes sure the IDs in an issue's journals can be shown by
+// name: the global lists (statuses, trackers, priorities) are loaded if
+// missing and other unknown IDs are fetched one by one. Each ID is only
+// requested once; failures keep the placeholder.
+func (m *Model) resolveNames(issue api.Issue) tea.Cmd {
+	m.seedLookups(issue)
+
+	var cmds []tea.Cmd
+	want := func(kind string, id int) {
+		key := lookupKey(kind, id)
+		if id == 0 || m.known(kind, id) || m.lookupFetching[key] {
+			return
+		}
+		switch kind {
+		case "status", "tracker", "priority":
+			// Small global lists: load them whole, once
+			listKey := lookupKey(kind, 0)
+			if m.lookupFetching[listKey] {
+				return
+			}
+			m.lookupFetching[listKey] = true
+			switch {
+			case kind == "status" && len(m.availableStatuses) == 0:
+				cmds = append(cmds, appui.SendLoadingMsg("Fetching statuses..."), fetchStatuses(m.client))
+			case kind == "tracker" && len(m.availableTrackers) == 0:
+				cmds = append(cmds, appui.SendLoadingMsg("Fetching trackers..."), fetchTrackers(m.client))
+			case kind == "priority" && len(m.availablePriorities) == 0:
+				cmds = append(cmds, appui.SendLoadingMsg("Fetching priorities..."), fetchPriorities(m.client))
+			}
+		default:
+			m.lookupFetching[key] = true
+			cmds = append(cmds, fetchLookup(m.client, kind, id))
+		}
+	}
+
+	for _, j := range issue.Journals {
+		want("user", j.User.ID)
+		for _, d := range j.Details {
+			if d.Property != "attr" {
+				continue
+			}
+			name := d.Name
+			if _, ok := lookupFields[name]; !ok {
+				name += "_id" // older servers omit the suffix
+			}
+			kind, ok := lookupFields[name]
+			if !ok {
+				continue
+			}
+			for _, value := range []string{d.OldValue, d.NewValue} {
+				if id, err := strconv.Atoi(value); err == nil {
+					want(kind, id)
+				}
+			}
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// fetchLookup fetches the name of a single user, project, version or category
+func fetchLookup(client *api.Client, kind string, id int) tea.Cmd {
+	return func() tea.Msg {
+		msg := lookupLoadedMsg{kind: kind, id: id}
+		switch kind {
+		case "user":
+			var u *api.User
+			if u, msg.err = client.GetUser(id); msg.err == nil {
+				msg.name = userDisplayName(*u)
+			}
+		case "project":
+			var p *api.Project
+			if p, msg.err = client.GetProject(id); msg.err == nil {
+				msg.name = p.Name
+			}
+		case "version":
+			var v *api.Version
+			if v, msg.err = client.GetVersion(id); msg.err == nil {
+				msg.name = v.Name
+			}
+		case "category":
+			var c *api.IssueCategory
+			if c, msg.err = client.GetIssueCategory(id); msg.err == nil {
+				msg.name = c.Name
+			}
+		}
+		return msg
+	}
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -13,13 +14,15 @@ import (
 // Message types for Bubble Tea update loop
 
 type issuesLoadedMsg struct {
-	issues []api.Issue
-	err    error
+	issues    []api.Issue
+	fetchedAt time.Time // when the request was sent; the next sync starts here
+	err       error
 }
 
 type issueDetailMsg struct {
-	issue *api.Issue
-	err   error
+	issueID int
+	issue   *api.Issue
+	err     error
 }
 
 type currentUserMsg struct {
@@ -43,79 +46,97 @@ type hideLoadingMsg struct{}
 
 // Fetch commands that return messages
 
-func fetchIssues(client *api.Client, viewMode string, assigneeFilter string, projectFilter string, issues []api.Issue) tea.Cmd {
-	return func() tea.Msg {
-		var resp *api.IssuesResponse
-		var err error
-
-		// Determine project ID if projectFilter is set
-		projectID := 0
-		if projectFilter != "" && len(issues) > 0 {
-			// Try to find project ID from existing issues
-			for _, issue := range issues {
-				if strings.EqualFold(issue.Project.Name, projectFilter) {
-					projectID = issue.Project.ID
-					break
-				}
+// issueQueryFor translates the current view mode and filters into the API
+// query used to fetch the issue list.
+func issueQueryFor(viewMode string, assigneeFilter string, projectFilter string, issues []api.Issue) api.IssueQuery {
+	// Determine project ID if projectFilter is set
+	projectID := 0
+	if projectFilter != "" && len(issues) > 0 {
+		// Try to find project ID from existing issues
+		for _, issue := range issues {
+			if strings.EqualFold(issue.Project.Name, projectFilter) {
+				projectID = issue.Project.ID
+				break
 			}
 		}
+	}
 
-		// Determine user ID if assigneeFilter is set
-		userID := 0
-		if assigneeFilter != "" && viewMode == "user" {
-			// Try to parse as number first (from list selection)
-			var parseErr error
-			userID, parseErr = strconv.Atoi(assigneeFilter)
-			if parseErr != nil || userID == 0 {
-				// Fall back to searching by name in existing issues
-				for _, issue := range issues {
-					if issue.AssignedTo != nil && strings.EqualFold(issue.AssignedTo.Name, assigneeFilter) {
-						userID = issue.AssignedTo.ID
-						break
-					}
+	// Determine user ID if assigneeFilter is set
+	userID := 0
+	if assigneeFilter != "" && viewMode == "user" {
+		// Try to parse as number first (from list selection)
+		var parseErr error
+		userID, parseErr = strconv.Atoi(assigneeFilter)
+		if parseErr != nil || userID == 0 {
+			// Fall back to searching by name in existing issues
+			for _, issue := range issues {
+				if issue.AssignedTo != nil && strings.EqualFold(issue.AssignedTo.Name, assigneeFilter) {
+					userID = issue.AssignedTo.ID
+					break
 				}
 			}
 		}
+	}
 
-		switch viewMode {
-		case "my":
-			// Fetch issues assigned to me
-			resp, err = client.GetIssues(projectID, true, 0, true, 100, 0)
-		case "all":
-			// Fetch all open issues
-			resp, err = client.GetIssues(projectID, false, 0, true, 100, 0)
-		case "user":
-			// Fetch issues for specific user
-			resp, err = client.GetIssues(projectID, false, userID, true, 100, 0)
-		case "user-multi":
-			// Fetch all issues for client-side filtering by multiple users
-			resp, err = client.GetIssues(projectID, false, 0, true, 100, 0)
-		case "project-multi":
-			// Fetch all issues for client-side filtering by multiple projects
-			resp, err = client.GetIssues(0, false, 0, true, 100, 0)
-		case "user-project-multi":
-			// Fetch all issues for client-side filtering by both users and projects
-			resp, err = client.GetIssues(0, false, 0, true, 100, 0)
-		default:
-			// Default to all issues
-			resp, err = client.GetIssues(projectID, false, 0, true, 100, 0)
+	q := api.IssueQuery{ProjectID: projectID, StatusID: "open", Limit: 100}
+	switch viewMode {
+	case "my":
+		// Fetch issues assigned to me
+		q.AssignedTo = "me"
+	case "user":
+		// Fetch issues for specific user
+		if userID > 0 {
+			q.AssignedTo = strconv.Itoa(userID)
 		}
+	case "project-multi", "user-project-multi":
+		// Fetch all issues for client-side filtering by projects (and users)
+		q.ProjectID = 0
+	}
+	// "all", "user-multi" and unknown modes fetch all open issues; multi
+	// selections are filtered client-side.
+	return q
+}
 
+func fetchIssues(client *api.Client, viewMode string, assigneeFilter string, projectFilter string, issues []api.Issue) tea.Cmd {
+	q := issueQueryFor(viewMode, assigneeFilter, projectFilter, issues)
+	return func() tea.Msg {
+		fetchedAt := time.Now()
+		resp, err := client.GetIssuesQuery(context.Background(), q)
 		if err != nil {
 			return issuesLoadedMsg{err: err}
 		}
-		return issuesLoadedMsg{issues: resp.Issues}
+		return issuesLoadedMsg{issues: resp.Issues, fetchedAt: fetchedAt}
 	}
 }
 
-func fetchIssueDetail(client *api.Client, issueID int) tea.Cmd {
+func fetchIssueDetail(ctx context.Context, client *api.Client, issueID int) tea.Cmd {
 	return func() tea.Msg {
-		issue, err := client.GetIssue(issueID)
+		issue, err := client.GetIssueContext(ctx, issueID)
 		if err != nil {
-			return issueDetailMsg{err: err}
+			return issueDetailMsg{issueID: issueID, err: err}
 		}
-		return issueDetailMsg{issue: issue}
+		return issueDetailMsg{issueID: issueID, issue: issue}
+	}
+}
+
+// fetchSelectedDetail fetches full details for an issue, cancelling the
+// detail request still in flight for the previous selection (if any).
+func (m *Model) fetchSelectedDetail(issueID int) tea.Cmd {
+	if m.detailCancel != nil {
+		m.detailCancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.detailCancel = cancel
+	return fetchIssueDetail(ctx, m.client, issueID)
+}
+
+// selectedIssueID returns the ID of the issue under the cursor, or 0
+func (m *Model) selectedIssueID() int {
+	filteredIssues := m.getFilteredIssues()
+	if m.selectedIndex >= 0 && m.selectedIndex < len(filteredIssues) {
+		return filteredIssues[m.selectedIndex].ID
 	}
+	return 0
 }
 
 func tickCmd() tea.Cmd {
@@ -1,9 +1,13 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -29,6 +33,16 @@ type Model struct {
 	leftTitle           string
 	rightTitle          string
 	client              *api.Client
+	detailCancel        context.CancelFunc // cancels the in-flight detail fetch
+	detailSeq           int                // bumped per selection; stale debounce ticks are ignored
+	detailCache         map[int]api.Issue  // issue ID -> full details (valid while updated_on matches)
+	prefetching         map[int]bool       // issue IDs with a background prefetch in flight
+	prefetchCtx         context.Context    // shared by the current selection's prefetches
+	prefetchCancel      context.CancelFunc // cancels them when the selection moves
+	prefetchSem         chan struct{}      // bounds concurrent prefetch requests
+	lastSync            time.Time          // when the issue list was last synced with the server
+	refreshing          bool               // whether a background poll is in flight
+	unseenUpdates       map[int]bool       // issue IDs changed by background refresh and not viewed yet
 	issues              []api.Issue
 	selectedIndex       int
 	selectedDisplayLine int // Line number where selected issue is displayed
@@ -56,17 +70,25 @@ type Model struct {
 	filteredIndices      []int          // indices of filtered items in original list
 
 	// Edit mode state
-	editMode            bool              // whether edit mode is active
-	editFieldIndex      int               // which field is currently selected for editing
-	editInput           textinput.Model   // input for editing
-	editingIssueID      int               // ID of the issue being edited
-	availableStatuses   []api.Status      // available statuses for selection
-	availablePriorities []api.Priority    // available priorities for selection
-	hasUnsavedChanges   bool              // whether there are unsaved changes in edit mode
-	editOriginalValue   string            // original value before editing
-	pendingEdits        map[string]string // fieldName -> new value for all pending edits
-	originalValues      map[string]string // fieldName -> original value for comparison
-	editedFields        map[string]bool   // fieldName -> whether the user actually edited it this session
+	editMode            bool                        // whether edit mode is active
+	editFieldIndex      int                         // which field is currently selected for editing
+	editInput           textinput.Model             // input for editing
+	editingIssueID      int                         // ID of the issue being edited
+	availableStatuses   []api.Status                // available statuses for selection
+	availablePriorities []api.Priority              // available priorities for selection
+	availableTrackers   []api.Tracker               // all trackers, used until a project's are loaded
+	projectTrackers     map[int][]api.Tracker       // project ID -> trackers enabled in it
+	projectCategories   map[int][]api.IssueCategory // project ID -> its issue categories
+	projectFieldErrors  map[string]string           // "kind:projectID" option lists that failed to load -> error
+	lookupCache         map[string]string           // "kind:id" -> name seen on issues or fetched (see lookup.go)
+	lookupFetching      map[string]bool             // "kind:id" lookups already requested
+	hasUnsavedChanges   bool                        // whether there are unsaved changes in edit mode
+	editOriginalValue   string                      // original value before editing
+	pendingEdits        map[string]string           // fieldName -> new value for all pending edits
+	originalValues      map[string]string           // fieldName -> original value for comparison
+	editedFields        map[string]bool             // fieldName -> whether the user actually edited it this session
+	savingEdits         bool                        // whether an edit-mode save is in flight
+	saveErrors          map[string]string           // fieldName -> error from the last failed save ("" = not tied to a field)
 
 	// Modal state
 	showModal   bool   // whether a modal is currently displayed
@@ -77,6 +99,21 @@ type Model struct {
 	noteMode    bool           // whether the add-note input is active
 	noteInput   textarea.Model // multi-line input for the note
 	noteIssueID int            // ID of the issue the note will be added to
+	notePrivate bool           // post the note as a private note (or keep an edited one private)
+	noteJournal int            // journal whose notes are being edited (0 = new note)
+
+	complete completion // @user / #issue completion in the note, quick-note and description editors
+
+	// Note template picker (Ctrl+R in the note editor and quick-actions note)
+	templatePick   bool                   // whether the picker is open
+	templateCursor int                    // selected template
+	templates      []config.NoteTemplate  // templates read when the picker opened
+	templateNotice string                 // why the last template couldn't be read or fully applied
+	noteUpdates    map[string]interface{} // status/assignee changes a template adds to the note
+
+	// Note selected in the details pane for replies
+	journalCursor int // selected journal ID (0 = none, i.e. the latest note)
+	journalLine   int // line of the selected note in the details pane (-1 = not shown)
 
 	// Multi-line description editor state
 	descEditMode bool           // whether the multi-line description editor is open
@@ -91,20 +128,121 @@ type Model struct {
 	// Quick-actions popup state (status + assignee + note in one dialog)
 	quickMode           bool           // whether the quick-actions popup is open
 	quickIssueID        int            // ID of the issue being acted on
-	quickField          int            // focused field: 0=status, 1=assignee, 2=note
-	quickStatusIdx      int            // selected index into availableStatuses
+	quickField          int            // focused field, an index into quickFields()
+	quickStatusIdx      int            // selected index into availableStatuses (-1 = no change, bulk only)
 	quickOrigStatusID   int            // status ID when the popup opened
 	quickAssigneeFilter string         // type-to-filter text for the assignee
 	quickAssigneeSel    int            // selected index into the filtered assignee list
 	quickOrigAssigneeID int            // assignee ID when the popup opened (0 = unassigned)
 	quickNote           textarea.Model // multi-line note input
+	quickBulkIDs        []int          // marked issues the popup applies to (empty = single issue)
+	quickPriorityIdx    int            // selected index into availablePriorities (-1 = no change)
+	quickVersionIdx     int            // selected index into quickVersionOptions() (-1 = no change)
+	quickNotePrivate    bool           // post the note as a private note
+
+	// Notification center state
+	notifications []notification // newest first
+	notifyCursor  int            // cursor in the notification list
+	notifySync    time.Time      // when notifications were last polled (zero = no baseline yet)
+	notifyPolling bool           // whether a notification poll is in flight
+	knownAssigned map[int]bool   // issue IDs already known to be assigned to me
+	seenJournals  map[int]bool   // journal IDs already considered for notifications
+
+	// Multi-select and bulk update state
+	markedIssues    map[int]bool          // issue IDs marked for bulk operations
+	markAnchor      int                   // ID of the last toggled issue, for range marking (0 = none)
+	projectVersions map[int][]api.Version // project ID -> versions available to its issues
+	bulkItems       []bulkItem            // per-issue progress of the current bulk update
+	bulkRunning     int                   // bulk updates still in flight
+
+	// Undo state
+	changeLog    []changeRecord // successful updates made this session, oldest first
+	changeSeq    int            // sequence number of the last logged change
+	changeCursor int            // selected index into changeLog in the change log
+
+	// Board view state
+	boardMode  bool   // whether the board replaces the list/details panes
+	boardGroup string // field the columns group by: "status", "assignee", ...
+	boardCol   int    // selected column
+	boardRow   int    // selected card within the column
+
+	// Timeline view state
+	timelineMode    bool              // whether the timeline replaces the list/details panes
+	timelineScale   string            // "day" or "week"
+	timelineStart   time.Time         // first day shown on the axis
+	timelineRow     int               // selected issue row
+	timelinePending map[int]api.Issue // issue ID -> issue as it was before unsaved date shifts
+
+	viewNotice string // why the last board/timeline update failed, or what was copied; shown in the footer
+
+	yankCursor int // selected option in the yank (copy) menu
+
+	keys        keyMap   // list view key bindings (defaults + config.yaml)
+	keyProblems []string // key binding problems found at startup
+
+	// Command palette state
+	paletteMode   bool            // whether the palette is open
+	paletteInput  textinput.Model // command query and arguments
+	paletteCursor int             // selected command in the filtered list
+
+	// Open-in-browser menu state
+	browserCursor int    // selected page in the menu
+	browserURL    string // URL that couldn't be opened, shown instead of the menu
+	browserErr    error  // why it couldn't be opened
+
+	// Roadmap state
+	roadmapCursor      int                 // selected version
+	roadmapDrill       int                 // version whose issues are listed (0 = version list)
+	roadmapIssueCursor int                 // selected issue in the drilled-into version
+	roadmapShowClosed  bool                // whether closed versions are listed
+	roadmapIssues      map[int][]api.Issue // version ID -> issues targeting it
+	roadmapFetching    map[int]bool        // version IDs with an issue fetch in flight
+
+	// Calendar overlay state
+	calendarDay      time.Time // selected day (its month is shown)
+	calendarUseStart bool      // place issues on start dates instead of due dates
+	calendarList     bool      // whether the selected day's issue list has the cursor
+	calendarCursor   int       // cursor in the day's issue list
+
+	// Journal history view state
+	historyIssueID int    // issue whose history is shown
+	historyKind    string // "all", "notes" (comments only) or "changes" (field changes only)
+	historyUser    int    // only show entries by this user ID (0 = everyone)
+	historyCursor  int    // selected entry in the filtered journals
+	historyDelete  int    // journal ID awaiting confirmation to delete its note
+	historyNotice  string // result of the last note edit or delete
+
+	diffStats map[int][2]int // journal ID -> lines added and removed by its description change
+
+	// API key prompt state (shown when the server answers 401)
+	apiKeyMode  bool            // whether the API key prompt is open
+	apiKeyInput textinput.Model // input for the replacement key
+	apiKeyErr   string          // error from saving the new key, if any
 
 	// Loading indicator
 	loadingIndicator ui.LoadingModel
 }
 
-func InitialModel() Model {
+// newClient builds the API client from the current settings
+func newClient() *api.Client {
 	client := api.NewClient(config.Current.Redmine.URL, config.Current.Redmine.APIKey)
+	settings := config.Current.API
+	if settings.MaxRetries < 0 {
+		client.Retry.MaxRetries = 0
+	} else if settings.MaxRetries > 0 {
+		client.Retry.MaxRetries = settings.MaxRetries
+	}
+	if settings.RetryBaseDelay > 0 {
+		client.Retry.BaseDelay = settings.RetryBaseDelay
+	}
+	if settings.RetryMaxDelay > 0 {
+		client.Retry.MaxDelay = settings.RetryMaxDelay
+	}
+	return client
+}
+
+func InitialModel() Model {
+	client := newClient()
 	filterInput := textinput.New()
 	filterInput.Placeholder = "Type to filter issues..."
 	filterInput.CharLimit = 100
@@ -127,30 +265,70 @@ func InitialModel() Model {
 	descInput.SetWidth(58)
 	descInput.SetHeight(10)
 
+	apiKeyInput := textinput.New()
+	apiKeyInput.Placeholder = "API key"
+	apiKeyInput.EchoMode = textinput.EchoPassword
+	apiKeyInput.Width = 50
+
+	paletteInput := textinput.New()
+	paletteInput.Placeholder = "Type a command, e.g. goto 1234, assign @bob, status Resolved"
+	paletteInput.CharLimit = 100
+	paletteInput.Width = 60
+
 	quickNote := textarea.New()
 	quickNote.Placeholder = "Optional note..."
 	quickNote.CharLimit = 5000
 	quickNote.SetWidth(58)
 	quickNote.SetHeight(4)
 
+	keys, keyProblems := newKeyMap(config.Current.Keys)
+	showModal, modalType := false, ""
+	if len(keyProblems) > 0 {
+		// Report conflicting or unknown bindings right away
+		showModal, modalType = true, "keys"
+	}
+
 	return Model{
-		leftTitle:        "Issues",
-		rightTitle:       "Details",
-		activePane:       0,
-		client:           client,
-		selectedIndex:    0,
-		loading:          true,
-		filterInput:      filterInput,
-		editInput:        editInput,
-		noteInput:        noteInput,
-		descInput:        descInput,
-		quickNote:        quickNote,
-		viewMode:         "my",
-		selectedUsers:    make(map[int]bool),
-		selectedProjects: make(map[int]bool),
-		editMode:         false,
-		editFieldIndex:   0,
-		loadingIndicator: ui.NewLoadingModel(),
+		keys:               keys,
+		keyProblems:        keyProblems,
+		showModal:          showModal,
+		modalType:          modalType,
+		leftTitle:          "Issues",
+		rightTitle:         "Details",
+		activePane:         0,
+		client:             client,
+		selectedIndex:      0,
+		loading:            true,
+		filterInput:        filterInput,
+		editInput:          editInput,
+		noteInput:          noteInput,
+		descInput:          descInput,
+		quickNote:          quickNote,
+		paletteInput:       paletteInput,
+		apiKeyInput:        apiKeyInput,
+		viewMode:           "my",
+		detailCache:        make(map[int]api.Issue),
+		prefetching:        make(map[int]bool),
+		unseenUpdates:      make(map[int]bool),
+		markedIssues:       make(map[int]bool),
+		timelinePending:    make(map[int]api.Issue),
+		roadmapIssues:      make(map[int][]api.Issue),
+		roadmapFetching:    make(map[int]bool),
+		diffStats:          make(map[int][2]int),
+		projectVersions:    make(map[int][]api.Version),
+		projectTrackers:    make(map[int][]api.Tracker),
+		projectCategories:  make(map[int][]api.IssueCategory),
+		projectFieldErrors: make(map[string]string),
+		lookupCache:        make(map[string]string),
+		lookupFetching:     make(map[string]bool),
+		knownAssigned:      make(map[int]bool),
+		seenJournals:       make(map[int]bool),
+		prefetchSem:        make(chan struct{}, prefetchConcurrency),
+		selectedUsers:      make(map[int]bool),
+		selectedProjects:   make(map[int]bool),
+		editMode:           false,
+		editFieldIndex:     0,
+		loadingIndicator:   ui.NewLoadingModel(),
 	}
 }
 
@@ -167,6 +345,8 @@ func (m Model) Init() tea.Cmd {
 		ui.SendLoadingMsg("Fetching statuses..."),
 		fetchStatuses(m.client),
 		tickCmd(),
+		refreshTickCmd(),
+		notifyTickCmd(),
 	)
 }
 
@@ -178,24 +358,52 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.loadingIndicator, cmd = m.loadingIndicator.Update(msg)
 	cmds = append(cmds, cmd)
 
+	// A rejected API key can surface from any request; ask for a new one
+	if errors.Is(requestErr(msg), api.ErrUnauthorized) && !m.apiKeyMode {
+		cmds = append(cmds, m.openAPIKeyPrompt())
+	}
+
 	switch msg := msg.(type) {
 	case issuesLoadedMsg:
 		m.loading = false
 		if msg.err != nil {
 			m.err = msg.err
 			m.loadingIndicator.Hide()
-			return m, nil
+			return m, tea.Batch(cmds...)
 		}
 		// Mark "Initializing application" as complete
 		cmds = append(cmds, ui.SendLoadingCompleteMsg())
 
+		// Keep the cursor on the same issue across reloads, or start at
+		// the top if it is no longer listed
+		selectedID := m.selectedIssueID()
 		m.issues = msg.issues
+		m.lastSync = msg.fetchedAt
+		m.seedLookups(m.issues...)
 		if len(m.issues) > 0 {
 			m.selectedIndex = 0
+			for i, issue := range m.getFilteredIssues() {
+				if issue.ID == selectedID {
+					m.selectedIndex = i
+					break
+				}
+			}
+			if m.boardMode {
+				cols := m.boardColumns()
+				m.focusBoardIssue(cols, selectedID)
+				m.clampBoard(cols)
+				m.syncBoardSelection(cols)
+			}
 			// Fetch details for first issue
 			cmds = append(cmds, ui.SendLoadingCompleteMsg()) // Mark issues fetch as complete
-			cmds = append(cmds, ui.SendLoadingMsg("Fetching issue details..."))
-			cmds = append(cmds, fetchIssueDetail(m.client, m.issues[0].ID))
+			if id := m.selectedIssueID(); id != 0 {
+				if m.applyCachedDetail(id) {
+					cmds = append(cmds, m.prefetchNeighbors())
+				} else {
+					cmds = append(cmds, ui.SendLoadingMsg("Fetching issue details..."))
+					cmds = append(cmds, m.fetchSelectedDetail(id))
+				}
+			}
 		} else {
 			cmds = append(cmds, ui.SendLoadingCompleteMsg())
 		}
@@ -206,6 +414,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 
 	case issueDetailMsg:
+		if msg.err == nil && msg.issue != nil {
+			m.cacheDetail(*msg.issue)
+			cmds = append(cmds, m.resolveNames(*msg.issue))
+		}
+		// Drop results for issues the cursor has already moved away from
+		// (including requests cancelled for that reason).
+		if msg.issueID != m.selectedIssueID() {
+			return m, tea.Batch(cmds...)
+		}
 		if msg.err == nil && msg.issue != nil {
 			// Update the issue in the list with full details including journals
 			for i, issue := range m.issues {
@@ -214,11 +431,191 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					break
 				}
 			}
+			if m.showModal && m.modalType == "history" && m.historyCursor < 0 {
+				m.historyCursor = len(m.historyEntries()) - 1
+			}
 			if m.ready {
 				m.updatePaneContent()
 			}
 			// Mark as complete
 			cmds = append(cmds, ui.SendLoadingCompleteMsg())
+			cmds = append(cmds, m.prefetchNeighbors())
+		}
+		return m, tea.Batch(cmds...)
+
+	case notifyTickMsg:
+		cmds = append(cmds, notifyTickCmd())
+		if m.currentUser != nil && !m.notifyPolling {
+			m.notifyPolling = true
+			cmds = append(cmds, pollNotifications(m.client, m.notifySync))
+		}
+		return m, tea.Batch(cmds...)
+
+	case refreshTickMsg:
+		cmds = append(cmds, refreshTickCmd())
+		// Skip this round while something else is reloading or being edited
+		if m.loading || m.editMode || m.refreshing || m.lastSync.IsZero() {
+			return m, tea.Batch(cmds...)
+		}
+		m.refreshing = true
+		cmds = append(cmds, fetchIssueChanges(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues, m.lastSync.Add(-syncOverlap)))
+		return m, tea.Batch(cmds...)
+
+	case notificationPollMsg:
+		m.notifyPolling = false
+		if msg.err != nil {
+			return m, tea.Batch(cmds...)
+		}
+		cmds = append(cmds, m.addNotifications(m.processNotificationPoll(msg)))
+		m.notifySync = msg.fetchedAt
+		return m, tea.Batch(cmds...)
+
+	case issueChangesMsg:
+		m.refreshing = false
+		if msg.err != nil || m.loading || m.editMode {
+			return m, tea.Batch(cmds...)
+		}
+		m.lastSync = msg.fetchedAt
+		changed := m.mergeIssueChanges(msg.issues, msg.left)
+		selectedID := m.selectedIssueID()
+		for _, id := range changed {
+			if id == selectedID {
+				// The list entry has no journals; refresh the open details
+				cmds = append(cmds, m.fetchSelectedDetail(id))
+				break
+			}
+		}
+		if m.ready {
+			m.updatePaneContent()
+		}
+		return m, tea.Batch(cmds...)
+
+	case versionsLoadedMsg:
+		cmds = append(cmds, m.projectFieldLoaded("versions", msg.projectID, "versions", msg.err))
+		if msg.err == nil {
+			m.projectVersions[msg.projectID] = msg.versions
+			if m.showModal && m.modalType == "roadmap" {
+				cmds = append(cmds, m.fetchRoadmapIssues())
+			}
+		}
+		cmds = append(cmds, m.revalidateProjectFields())
+		m.updatePaneContent()
+		return m, tea.Batch(cmds...)
+
+	case trackersLoadedMsg:
+		cmds = append(cmds, m.projectFieldLoaded("trackers", 0, "trackers", msg.err))
+		if msg.err == nil {
+			m.availableTrackers = msg.trackers
+		}
+		cmds = append(cmds, m.revalidateProjectFields())
+		m.updatePaneContent()
+		return m, tea.Batch(cmds...)
+
+	case projectTrackersLoadedMsg:
+		cmds = append(cmds, m.projectFieldLoaded("project-trackers", msg.projectID, "project trackers", msg.err))
+		if msg.err == nil {
+			m.projectTrackers[msg.projectID] = msg.trackers
+		}
+		cmds = append(cmds, m.revalidateProjectFields())
+		m.updatePaneContent()
+		return m, tea.Batch(cmds...)
+
+	case categoriesLoadedMsg:
+		cmds = append(cmds, m.projectFieldLoaded("categories", msg.projectID, "categories", msg.err))
+		if msg.err == nil {
+			m.projectCategories[msg.projectID] = msg.categories
+		}
+		cmds = append(cmds, m.revalidateProjectFields())
+		m.updatePaneContent()
+		return m, tea.Batch(cmds...)
+
+	case yankedMsg:
+		if msg.err != nil {
+			m.viewNotice = "Copy failed: " + msg.err.Error()
+		} else {
+			m.viewNotice = fmt.Sprintf("Copied %s (%s)", excerpt(msg.text, 40), msg.via)
+		}
+		return m, nil
+
+	case gotoIssueMsg:
+		cmds = append(cmds, ui.SendLoadingCompleteMsg())
+		if msg.err != nil {
+			m.viewNotice = fmt.Sprintf("Couldn't load #%d: %v", msg.issueID, msg.err)
+			return m, tea.Batch(cmds...)
+		}
+		m.issues = append([]api.Issue{*msg.issue}, m.issues...)
+		m.seedLookups(*msg.issue)
+		if cmd, ok := m.selectIssue(msg.issueID); ok {
+			cmds = append(cmds, cmd)
+		} else {
+			m.viewNotice = fmt.Sprintf("#%d is hidden by the current filters", msg.issueID)
+		}
+		return m, tea.Batch(cmds...)
+
+	case browserOpenedMsg:
+		if msg.err != nil {
+			m.showURL(msg.url, msg.err)
+		} else {
+			m.viewNotice = "Opened " + excerpt(msg.url, 60)
+		}
+		return m, nil
+
+	case journalUpdatedMsg:
+		m.loading = false
+		cmds = append(cmds, ui.SendLoadingCompleteMsg())
+		switch {
+		case msg.err != nil:
+			m.historyNotice = "Couldn't change the note: " + msg.err.Error()
+		case msg.deleted:
+			m.historyNotice = "Note deleted"
+		default:
+			m.historyNotice = "Note updated"
+		}
+		m.reopenHistory()
+		if msg.err == nil {
+			cmds = append(cmds, m.fetchSelectedDetail(msg.issueID))
+		}
+		return m, tea.Batch(cmds...)
+
+	case lookupLoadedMsg:
+		if msg.err == nil && msg.name != "" {
+			m.lookupCache[lookupKey(msg.kind, msg.id)] = msg.name
+			m.updatePaneContent()
+		}
+		return m, tea.Batch(cmds...)
+
+	case versionIssuesMsg:
+		delete(m.roadmapFetching, msg.versionID)
+		if msg.err == nil {
+			m.roadmapIssues[msg.versionID] = msg.issues
+			cmds = append(cmds, ui.SendLoadingCompleteMsg())
+		}
+		return m, tea.Batch(cmds...)
+
+	case bulkItemDoneMsg:
+		cmds = append(cmds, m.finishBulkItem(msg))
+		return m, tea.Batch(cmds...)
+
+	case timelineSavedMsg:
+		cmds = append(cmds, m.finishTimelineSave(msg))
+		return m, tea.Batch(cmds...)
+
+	case detailDebounceMsg:
+		if msg.seq != m.detailSeq || msg.issueID != m.selectedIssueID() {
+			return m, tea.Batch(cmds...)
+		}
+		cmds = append(cmds, m.fetchSelectedDetail(msg.issueID))
+		return m, tea.Batch(cmds...)
+
+	case detailPrefetchedMsg:
+		if errors.Is(msg.err, context.Canceled) {
+			// Dropped with its selection; prefetching was reset then
+			return m, nil
+		}
+		delete(m.prefetching, msg.issueID)
+		if msg.err == nil && msg.issue != nil {
+			m.cacheDetail(*msg.issue)
+			cmds = append(cmds, m.resolveNames(*msg.issue))
 		}
 		return m, tea.Batch(cmds...)
 
@@ -226,6 +623,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err == nil && msg.user != nil {
 			m.currentUser = msg.user
 			cmds = append(cmds, ui.SendLoadingCompleteMsg())
+			// Take the notification baseline now, so anything assigned
+			// from here on is reported
+			if notificationInterval() > 0 && m.notifySync.IsZero() && !m.notifyPolling {
+				m.notifyPolling = true
+				cmds = append(cmds, pollNotifications(m.client, time.Time{}))
+			}
 		}
 		return m, tea.Batch(cmds...)
 
@@ -255,6 +658,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err == nil {
 			m.availableStatuses = msg.statuses
 			cmds = append(cmds, ui.SendLoadingCompleteMsg())
+			m.updatePaneContent()
 		}
 		return m, tea.Batch(cmds...)
 
@@ -262,11 +666,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err == nil {
 			m.availablePriorities = msg.priorities
 			cmds = append(cmds, ui.SendLoadingCompleteMsg())
+			m.updatePaneContent()
 		}
 		return m, tea.Batch(cmds...)
 
 	case issueUpdatedMsg:
 		m.loading = false
+		if msg.err != nil && m.savingEdits && msg.issueID == m.editingIssueID {
+			// Keep the edit session open so nothing typed is lost; show the
+			// server's complaints next to the offending fields instead.
+			m.savingEdits = false
+			m.editMode = true
+			m.hasUnsavedChanges = len(m.pendingEdits) > 0
+			m.saveErrors = saveFieldErrors(msg.err)
+			m.editInput.Focus()
+			m.updatePaneContent()
+			return m, tea.Batch(cmds...)
+		}
+		m.savingEdits = false
+		m.saveErrors = nil
 		m.editMode = false
 		m.editInput.Blur()
 		m.noteMode = false
@@ -277,14 +695,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.originalValues = make(map[string]string)
 		m.editedFields = make(map[string]bool)
 		m.hasUnsavedChanges = false
-		if msg.err == nil {
-			// Refresh the issue list and details
+		if msg.err != nil {
+			if m.boardMode || m.timelineMode {
+				// Put moved cards and bars back where the server has them
+				m.viewNotice = "Update failed: " + msg.err.Error()
+				m.loading = true
+				cmds = append(cmds, ui.SendLoadingCompleteMsg())
+				cmds = append(cmds, fetchIssues(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues))
+			}
+			return m, tea.Batch(cmds...)
+		}
+		if msg.change != nil {
+			m.logChange(msg.change)
+		}
+		if msg.undoOf != 0 {
+			m.markUndone(msg.undoOf)
+		}
+		if msg.local {
+			// The moved card is already in place; just pick up the saved issue
 			cmds = append(cmds, ui.SendLoadingCompleteMsg())
-			cmds = append(cmds, ui.SendLoadingMsg("Refreshing issues..."))
-			cmds = append(cmds, fetchIssues(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues))
 			cmds = append(cmds, ui.SendLoadingMsg("Fetching updated issue..."))
-			cmds = append(cmds, fetchIssueDetail(m.client, msg.issueID))
+			cmds = append(cmds, m.fetchSelectedDetail(msg.issueID))
+			return m, tea.Batch(cmds...)
 		}
+		// Refresh the issue list and details
+		cmds = append(cmds, ui.SendLoadingCompleteMsg())
+		cmds = append(cmds, ui.SendLoadingMsg("Refreshing issues..."))
+		cmds = append(cmds, fetchIssues(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues))
+		cmds = append(cmds, ui.SendLoadingMsg("Fetching updated issue..."))
+		cmds = append(cmds, m.fetchSelectedDetail(msg.issueID))
 		return m, tea.Batch(cmds...)
 
 	case hideLoadingMsg:
@@ -328,9 +767,64 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.KeyMsg:
+		// The API key prompt takes over all keys while open
+		if m.apiKeyMode {
+			return m.updateAPIKeyPrompt(msg)
+		}
+
+		// The command palette takes all keys while open
+		if m.paletteMode {
+			return m.updatePalette(msg)
+		}
+
+		// The notification center has its own cursor and Enter handling
+		if m.showModal && m.modalType == "notifications" {
+			return m.updateNotificationCenter(msg)
+		}
+
+		// So does the session change log
+		if m.showModal && m.modalType == "changes" {
+			return m.updateChangeLog(msg)
+		}
+
+		// And the calendar and roadmap
+		if m.showModal && m.modalType == "calendar" {
+			return m.updateCalendar(msg)
+		}
+		if m.showModal && m.modalType == "roadmap" {
+			return m.updateRoadmap(msg)
+		}
+		if m.showModal && m.modalType == "history" {
+			return m.updateHistory(msg)
+		}
+		if m.showModal && m.modalType == "yank" {
+			return m.updateYankMenu(msg)
+		}
+		if m.showModal && m.modalType == "browser" {
+			return m.updateBrowserMenu(msg)
+		}
+
+		// Notices in the list view (e.g. what was copied) last until the next key
+		if !m.boardMode && !m.timelineMode {
+			m.viewNotice = ""
+		}
+
 		// Check if we're in any input mode - if so, only handle esc, enter, and pass to input
 		inInputMode := m.filterMode || m.userInputMode != "" || m.editMode || m.noteMode || m.descEditMode || m.statusPickMode || m.quickMode
 
+		// The board handles its own navigation; other keys (actions, filters,
+		// reload...) fall through to the normal bindings.
+		if m.boardMode && !inInputMode && !m.showModal {
+			if cmd, handled := m.updateBoard(msg); handled {
+				return m, cmd
+			}
+		}
+		if m.timelineMode && !inInputMode && !m.showModal {
+			if cmd, handled := m.updateTimeline(msg); handled {
+				return m, cmd
+			}
+		}
+
 		// Handle filter mode input FIRST - allow all keys to be typed
 		if m.filterMode {
 			switch msg.String() {
@@ -358,7 +852,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if len(filteredIssues) > 0 && m.selectedIndex > 0 {
 					m.selectedIndex--
 					m.updatePaneContent()
-					cmds = append(cmds, fetchIssueDetail(m.client, filteredIssues[m.selectedIndex].ID))
+					cmds = append(cmds, m.loadDetail(filteredIssues[m.selectedIndex].ID))
 				}
 				return m, tea.Batch(cmds...)
 			case "down":
@@ -367,7 +861,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if len(filteredIssues) > 0 && m.selectedIndex < len(filteredIssues)-1 {
 					m.selectedIndex++
 					m.updatePaneContent()
-					cmds = append(cmds, fetchIssueDetail(m.client, filteredIssues[m.selectedIndex].ID))
+					cmds = append(cmds, m.loadDetail(filteredIssues[m.selectedIndex].ID))
 				}
 				return m, tea.Batch(cmds...)
 			default:
@@ -384,24 +878,75 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle note mode input - all keys go to the textarea except Ctrl+S/Esc
 		if m.noteMode {
+			if m.templatePick {
+				m.updateTemplatePicker(msg, &m.noteInput, m.noteIssueID)
+				return m, nil
+			}
+			m.templateNotice = ""
+			if m.updateCompletion(msg, &m.noteInput) {
+				return m, nil
+			}
 			switch msg.String() {
 			case "esc":
 				// Cancel note without posting
+				m.complete = completion{}
+				m.templateNotice = ""
+				m.noteUpdates = nil
 				m.noteMode = false
 				m.noteInput.Blur()
 				m.noteInput.Reset()
+				if m.noteJournal != 0 {
+					m.noteJournal = 0
+					m.reopenHistory()
+				}
 				return m, nil
+			case "ctrl+o":
+				m.notePrivate = !m.notePrivate
+				return m, nil
+			case "ctrl+r":
+				return m, m.openTemplatePicker()
 			case "ctrl+s":
 				note := strings.TrimSpace(m.noteInput.Value())
+				updates := m.noteUpdates
+				m.complete = completion{}
+				m.templateNotice = ""
+				m.noteUpdates = nil
 				m.noteMode = false
 				m.noteInput.Blur()
+				if m.noteJournal != 0 {
+					// Editing an existing note; an empty note deletes it
+					journalID := m.noteJournal
+					m.noteJournal = 0
+					m.noteInput.Reset()
+					m.loading = true
+					return m, tea.Batch(
+						ui.SendLoadingMsg("Updating note..."),
+						updateJournal(m.client, m.noteIssueID, journalID, note, m.notePrivate),
+					)
+				}
+				if len(updates) > 0 {
+					// A template added a status/assignee change
+					issueID := m.noteIssueID
+					m.noteInput.Reset()
+					if note != "" {
+						updates["notes"] = note
+						if m.notePrivate {
+							updates["private_notes"] = true
+						}
+					}
+					m.loading = true
+					return m, tea.Batch(
+						ui.SendLoadingMsg("Applying changes..."),
+						updateIssueFields(m.client, issueID, updates, m.recordChange(issueID, updates)),
+					)
+				}
 				if note != "" {
 					issueID := m.noteIssueID
 					m.noteInput.Reset()
 					m.loading = true
 					return m, tea.Batch(
 						ui.SendLoadingMsg("Posting note..."),
-						addNote(m.client, issueID, note),
+						addNote(m.client, issueID, note, m.notePrivate),
 					)
 				}
 				// Empty note - just close
@@ -410,7 +955,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			default:
 				// Pass all other keys (including Enter for newlines) to the textarea
 				m.noteInput, cmd = m.noteInput.Update(msg)
-				cmds = append(cmds, cmd)
+				cmds = append(cmds, cmd, m.refreshCompletion(m.noteInput))
 				return m, tea.Batch(cmds...)
 			}
 		}
@@ -418,9 +963,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle the multi-line description editor - keys go to the textarea
 		// except Ctrl+S (apply into pending edits) and Esc (cancel).
 		if m.descEditMode {
+			if m.updateCompletion(msg, &m.descInput) {
+				return m, nil
+			}
 			switch msg.String() {
 			case "esc":
 				// Discard edits made in the editor, stay in edit mode
+				m.complete = completion{}
 				m.descEditMode = false
 				m.descInput.Blur()
 				return m, nil
@@ -431,6 +980,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.editedFields[field.Name] = true
 					m.hasUnsavedChanges = true
 				}
+				m.complete = completion{}
 				m.descEditMode = false
 				m.descInput.Blur()
 				m.updatePaneContent()
@@ -438,7 +988,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			default:
 				// Pass all other keys (including Enter for newlines) to the textarea
 				m.descInput, cmd = m.descInput.Update(msg)
-				cmds = append(cmds, cmd)
+				cmds = append(cmds, cmd, m.refreshCompletion(m.descInput))
 				return m, tea.Batch(cmds...)
 			}
 		}
@@ -475,7 +1025,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.loading = true
 				return m, tea.Batch(
 					ui.SendLoadingMsg("Updating status..."),
-					updateIssueStatus(m.client, issueID, status.ID),
+					updateIssueStatus(m.client, issueID, status.ID,
+						m.recordChange(issueID, map[string]interface{}{"status_id": status.ID})),
 				)
 			}
 			return m, nil
@@ -484,72 +1035,84 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle the quick-actions popup: Tab moves between status/assignee/note,
 		// Ctrl+S applies all changes at once, Esc cancels.
 		if m.quickMode {
+			if m.templatePick {
+				m.updateTemplatePicker(msg, &m.quickNote, m.quickIssueID)
+				return m, nil
+			}
+			m.templateNotice = ""
+			if m.quickFieldName() == "note" && m.updateCompletion(msg, &m.quickNote) {
+				return m, nil
+			}
 			switch msg.String() {
 			case "esc":
+				m.complete = completion{}
+				m.templateNotice = ""
 				m.quickMode = false
 				m.quickNote.Blur()
 				return m, nil
 			case "ctrl+s":
-				updates := make(map[string]interface{})
-				if len(m.availableStatuses) > 0 && m.quickStatusIdx < len(m.availableStatuses) {
-					if st := m.availableStatuses[m.quickStatusIdx]; st.ID != m.quickOrigStatusID {
-						updates["status_id"] = st.ID
-					}
-				}
-				opts := m.quickFilteredAssignees()
-				if len(opts) > 0 && m.quickAssigneeSel < len(opts) {
-					if sel := opts[m.quickAssigneeSel]; sel.ID != m.quickOrigAssigneeID {
-						if sel.ID == 0 {
-							updates["assigned_to_id"] = nil
-						} else {
-							updates["assigned_to_id"] = sel.ID
-						}
-					}
-				}
-				if note := strings.TrimSpace(m.quickNote.Value()); note != "" {
-					updates["notes"] = note
-				}
+				updates := m.quickUpdates()
 				issueID := m.quickIssueID
+				m.complete = completion{}
+				m.templateNotice = ""
 				m.quickMode = false
 				m.quickNote.Blur()
 				if len(updates) == 0 {
 					return m, nil
 				}
+				if len(m.quickBulkIDs) > 0 {
+					return m, m.startBulkUpdate(m.quickBulkIDs, updates)
+				}
 				m.loading = true
 				return m, tea.Batch(
 					ui.SendLoadingMsg("Applying changes..."),
-					updateIssueFields(m.client, issueID, updates),
+					updateIssueFields(m.client, issueID, updates, m.recordChange(issueID, updates)),
 				)
-			case "tab":
-				m.quickField = (m.quickField + 1) % 3
-				if m.quickField == 2 {
-					return m, m.quickNote.Focus()
+			case "ctrl+o":
+				m.quickNotePrivate = !m.quickNotePrivate
+				return m, nil
+			case "ctrl+r":
+				if m.quickFieldName() == "note" {
+					return m, m.openTemplatePicker()
 				}
-				m.quickNote.Blur()
 				return m, nil
-			case "shift+tab":
-				m.quickField = (m.quickField + 2) % 3
-				if m.quickField == 2 {
+			case "tab", "shift+tab":
+				m.complete = completion{}
+				n := len(m.quickFields())
+				if msg.String() == "tab" {
+					m.quickField = (m.quickField + 1) % n
+				} else {
+					m.quickField = (m.quickField + n - 1) % n
+				}
+				if m.quickFieldName() == "note" {
 					return m, m.quickNote.Focus()
 				}
 				m.quickNote.Blur()
 				return m, nil
 			}
 
-			switch m.quickField {
-			case 0: // status - cycle through the list
-				switch msg.String() {
-				case "left", "h", "up", "k":
-					if n := len(m.availableStatuses); n > 0 {
-						m.quickStatusIdx = (m.quickStatusIdx - 1 + n) % n
-					}
-				case "right", "l", "down", "j":
-					if n := len(m.availableStatuses); n > 0 {
-						m.quickStatusIdx = (m.quickStatusIdx + 1) % n
-					}
-				}
+			// In bulk mode the select fields have an extra "(no change)"
+			// entry at index -1.
+			keep := len(m.quickBulkIDs) > 0
+			delta := 0
+			switch msg.String() {
+			case "left", "h", "up", "k":
+				delta = -1
+			case "right", "l", "down", "j":
+				delta = 1
+			}
+
+			switch m.quickFieldName() {
+			case "status": // cycle through the list
+				m.quickStatusIdx = cycleIndex(m.quickStatusIdx, len(m.availableStatuses), delta, keep)
+				return m, nil
+			case "priority":
+				m.quickPriorityIdx = cycleIndex(m.quickPriorityIdx, len(m.availablePriorities), delta, keep)
 				return m, nil
-			case 1: // assignee - type to filter, arrows to step through matches
+			case "version":
+				m.quickVersionIdx = cycleIndex(m.quickVersionIdx, len(m.quickVersionOptions()), delta, keep)
+				return m, nil
+			case "assignee": // type to filter, arrows to step through matches
 				switch msg.String() {
 				case "left", "up":
 					if m.quickAssigneeSel > 0 {
@@ -571,16 +1134,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 				return m, nil
-			case 2: // note - free-form multi-line text
+			case "note": // free-form multi-line text
 				m.quickNote, cmd = m.quickNote.Update(msg)
-				cmds = append(cmds, cmd)
+				cmds = append(cmds, cmd, m.refreshCompletion(m.quickNote))
 				return m, tea.Batch(cmds...)
 			}
 			return m, nil
 		}
 
-		switch msg.String() {
-		case "ctrl+c", "q":
+		switch {
+		case msg.String() == "ctrl+c" || key.Matches(msg, m.keys.Quit):
 			if m.editMode && m.hasUnsavedChanges {
 				// Warn about unsaved changes but allow quit
 				m.editMode = false
@@ -596,7 +1159,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, tea.Quit
 
-		case "esc":
+		case msg.String() == "esc":
 			if m.showModal {
 				// Close modal and reset scroll
 				m.showModal = false
@@ -610,6 +1173,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.pendingEdits = make(map[string]string)
 				m.originalValues = make(map[string]string)
 				m.editedFields = make(map[string]bool)
+				m.saveErrors = nil
 				m.editInput.Blur()
 				m.updatePaneContent()
 				return m, nil
@@ -620,13 +1184,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.filterInput.Blur()
 				m.filterInput.SetValue("")
 				return m, nil
+			} else if !inInputMode && len(m.markedIssues) > 0 {
+				// Clear bulk-selection marks
+				m.clearMarks()
+				m.updatePaneContent()
+				return m, nil
 			}
 
-		case "ctrl+s":
+		case msg.String() == "ctrl+s":
 			if m.editMode && len(m.pendingEdits) > 0 {
 				// Save current field to pending before submitting
 				if m.editFieldIndex < len(editableFields) {
 					field := editableFields[m.editFieldIndex]
+					if m.rejectEditValue(field) {
+						m.updatePaneContent()
+						return m, nil
+					}
 					if field.Type != "multiline" {
 						if m.editedFields[field.Name] {
 							m.pendingEdits[field.Name] = m.editInput.Value()
@@ -636,12 +1209,28 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 
+				// A project move is only saved once the tracker, category and
+				// version have been checked against the new project's lists
+				if _, ok := m.pendingEdits["project_id"]; ok {
+					cmd := m.revalidateProjectFields()
+					if pending := m.projectFieldsPending(m.editProjectID()); len(pending) > 0 {
+						if m.saveErrors == nil {
+							m.saveErrors = make(map[string]string)
+						}
+						m.saveErrors[""] = projectFieldsWaiting + strings.Join(pending, ", ") + " to load, save again once they have"
+						m.updatePaneContent()
+						return m, cmd
+					}
+				}
+
 				// Save all pending changes at once
 				if len(m.pendingEdits) > 0 {
 					filteredIssues := m.getFilteredIssues()
 					if m.selectedIndex >= 0 && m.selectedIndex < len(filteredIssues) {
 						issueID := filteredIssues[m.selectedIndex].ID
 						m.editingIssueID = issueID
+						m.savingEdits = true
+						m.saveErrors = nil
 						m.loading = true
 						m.hasUnsavedChanges = false
 						m.editMode = false
@@ -652,7 +1241,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 
-		case "enter":
+		case msg.String() == "enter":
 			if m.editMode {
 				// Multi-line fields open a dedicated editor rather than cycling
 				if m.editFieldIndex < len(editableFields) && editableFields[m.editFieldIndex].Type == "multiline" {
@@ -669,12 +1258,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Save current field edit to pending edits before moving to next
 				if m.editFieldIndex < len(editableFields) {
 					field := editableFields[m.editFieldIndex]
+					if m.rejectEditValue(field) {
+						m.updatePaneContent()
+						return m, nil
+					}
 					if m.editedFields[field.Name] && field.Type != "multiline" {
 						m.pendingEdits[field.Name] = m.editInput.Value()
 					} else if field.Type != "multiline" {
 						// Remove from pending if the field was not edited
 						delete(m.pendingEdits, field.Name)
 					}
+					if field.Name == "project_id" {
+						cmds = append(cmds, m.revalidateProjectFields())
+					}
 				}
 
 				// Cycle to next field (like Tab)
@@ -695,7 +1291,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.hasUnsavedChanges = len(m.pendingEdits) > 0
 				m.editInput.Focus()
 				m.updatePaneContent()
-				return m, nil
+				return m, tea.Batch(cmds...)
 			} else if m.filterMode {
 				// Apply filter and exit filter mode
 				m.filterText = m.filterInput.Value()
@@ -815,11 +1411,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Open selected issue in browser or show details
 				filteredIssues := m.getFilteredIssues()
 				if len(filteredIssues) > 0 && m.selectedIndex < len(filteredIssues) {
-					cmds = append(cmds, fetchIssueDetail(m.client, filteredIssues[m.selectedIndex].ID))
+					cmds = append(cmds, m.fetchSelectedDetail(filteredIssues[m.selectedIndex].ID))
 				}
 			}
 
-		case "up", "k":
+		case key.Matches(msg, m.keys.Up):
 			if m.showModal {
 				// Scroll up in modal
 				if m.modalScroll > 0 {
@@ -871,20 +1467,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					if m.selectedIndex > 0 {
 						m.selectedIndex--
 						m.updatePaneContent()
-						// Fetch details for selected issue
-						cmds = append(cmds, fetchIssueDetail(m.client, filteredIssues[m.selectedIndex].ID))
+						// Show details for selected issue
+						cmds = append(cmds, m.loadDetail(filteredIssues[m.selectedIndex].ID))
 					}
 				}
 			} else {
-				if m.activePane == 0 {
-					m.leftPane, cmd = m.leftPane.Update(msg)
-				} else {
-					m.rightPane, cmd = m.rightPane.Update(msg)
-				}
-				cmds = append(cmds, cmd)
+				m.rightPane.ScrollUp(1)
 			}
 
-		case "down", "j":
+		case key.Matches(msg, m.keys.Down):
 			if m.showModal {
 				// Scroll down in modal
 				m.modalScroll++
@@ -934,44 +1525,65 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					if m.selectedIndex < len(filteredIssues)-1 {
 						m.selectedIndex++
 						m.updatePaneContent()
-						// Fetch details for selected issue
-						cmds = append(cmds, fetchIssueDetail(m.client, filteredIssues[m.selectedIndex].ID))
+						// Show details for selected issue
+						cmds = append(cmds, m.loadDetail(filteredIssues[m.selectedIndex].ID))
 					}
 				}
 			} else {
-				if m.activePane == 0 {
-					m.leftPane, cmd = m.leftPane.Update(msg)
-				} else {
-					m.rightPane, cmd = m.rightPane.Update(msg)
-				}
-				cmds = append(cmds, cmd)
+				m.rightPane.ScrollDown(1)
 			}
 
-		case "pgup", "b":
+		case key.Matches(msg, m.keys.PageUp):
 			if inInputMode {
 				// Don't page in input mode
 				return m, nil
 			}
 			if m.activePane == 0 {
-				m.leftPane, cmd = m.leftPane.Update(msg)
+				m.leftPane.PageUp()
 			} else {
-				m.rightPane, cmd = m.rightPane.Update(msg)
+				m.rightPane.PageUp()
 			}
-			cmds = append(cmds, cmd)
 
-		case "pgdown":
+		case key.Matches(msg, m.keys.PageDown):
 			if inInputMode {
 				// Don't page in input mode
 				return m, nil
 			}
 			if m.activePane == 0 {
-				m.leftPane, cmd = m.leftPane.Update(msg)
+				m.leftPane.PageDown()
 			} else {
-				m.rightPane, cmd = m.rightPane.Update(msg)
+				m.rightPane.PageDown()
 			}
-			cmds = append(cmds, cmd)
 
-		case " ": // Space key
+		case key.Matches(msg, m.keys.Home, m.keys.End):
+			if inInputMode || m.showModal {
+				return m, nil
+			}
+			// First/last issue, or top/end of the details
+			toEnd := key.Matches(msg, m.keys.End)
+			if m.activePane == 1 {
+				if toEnd {
+					m.rightPane.GotoBottom()
+				} else {
+					m.rightPane.GotoTop()
+				}
+				return m, nil
+			}
+			filteredIssues := m.getFilteredIssues()
+			if len(filteredIssues) == 0 {
+				return m, nil
+			}
+			index := 0
+			if toEnd {
+				index = len(filteredIssues) - 1
+			}
+			if index != m.selectedIndex {
+				m.selectedIndex = index
+				m.updatePaneContent()
+				cmds = append(cmds, m.loadDetail(filteredIssues[index].ID))
+			}
+
+		case msg.String() == " " && (m.editMode || m.userInputMode == "user" || m.userInputMode == "project"):
 			if m.editMode {
 				// Pass space to edit input
 				m.editInput, cmd = m.editInput.Update(msg)
@@ -1002,6 +1614,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					// Save current field edit to pending edits before moving to next
 					if m.editFieldIndex < len(editableFields) {
 						field := editableFields[m.editFieldIndex]
+						if m.rejectEditValue(field) {
+							m.updatePaneContent()
+							return m, nil
+						}
 						if field.Type != "multiline" {
 							if m.editedFields[field.Name] {
 								m.pendingEdits[field.Name] = m.editInput.Value()
@@ -1009,6 +1625,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 								delete(m.pendingEdits, field.Name)
 							}
 						}
+						if field.Name == "project_id" {
+							cmds = append(cmds, m.revalidateProjectFields())
+						}
 					}
 
 					// Cycle through editable fields
@@ -1029,7 +1648,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.hasUnsavedChanges = len(m.pendingEdits) > 0
 					m.editInput.Focus()
 					m.updatePaneContent()
-					return m, nil
+					return m, tea.Batch(cmds...)
 				}
 				// Multi-line fields are edited via the dedicated editor
 				// (press Enter to open it), so ignore inline typing here.
@@ -1057,8 +1676,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.buildFilteredList()
 			} else if !inInputMode {
 				// Handle command keys when NOT in input mode
-				switch msg.String() {
-				case "e":
+				switch {
+				case key.Matches(msg, m.keys.Edit):
 					// Enter edit mode
 					filteredIssues := m.getFilteredIssues()
 					if len(filteredIssues) > 0 && m.selectedIndex < len(filteredIssues) {
@@ -1067,6 +1686,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.pendingEdits = make(map[string]string)
 						m.originalValues = make(map[string]string)
 						m.editedFields = make(map[string]bool)
+						m.saveErrors = nil
+						m.projectFieldErrors = make(map[string]string) // retry lists that failed last time
 
 						// Store all original values
 						issue := filteredIssues[m.selectedIndex]
@@ -1088,6 +1709,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							cmds = append(cmds, ui.SendLoadingMsg("Fetching users..."))
 							cmds = append(cmds, fetchUsers(m.client))
 						}
+						if len(m.availableProjects) == 0 {
+							cmds = append(cmds, ui.SendLoadingMsg("Fetching projects..."))
+							cmds = append(cmds, fetchProjects(m.client))
+						}
+						cmds = append(cmds, m.projectFieldCmds(issue.Project.ID)...)
 
 						// Set initial value in edit input
 						field := editableFields[m.editFieldIndex]
@@ -1102,21 +1728,29 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						return m, tea.Batch(cmds...)
 					}
 					return m, nil
-				case "c":
+				case key.Matches(msg, m.keys.Note):
 					// Add a note/comment to the selected issue
 					filteredIssues := m.getFilteredIssues()
 					if len(filteredIssues) > 0 && m.selectedIndex < len(filteredIssues) {
 						m.noteMode = true
 						m.noteIssueID = filteredIssues[m.selectedIndex].ID
+						m.notePrivate = false
+						m.noteJournal = 0
+						m.noteUpdates = nil
 						m.noteInput.Reset()
 						return m, m.noteInput.Focus()
 					}
 					return m, nil
-				case "a":
-					// Quick-actions popup: status + assignee + note in one dialog
+				case key.Matches(msg, m.keys.Actions):
+					// Quick-actions popup: status + assignee + note in one dialog,
+					// applied to all marked issues if there are any
+					if len(m.markedIssues) > 0 {
+						return m, m.openBulkActions()
+					}
 					filteredIssues := m.getFilteredIssues()
 					if len(filteredIssues) > 0 && m.selectedIndex < len(filteredIssues) {
 						issue := filteredIssues[m.selectedIndex]
+						m.quickBulkIDs = nil
 						m.quickIssueID = issue.ID
 						m.quickField = 0
 						// Status: preselect current
@@ -1141,8 +1775,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 								break
 							}
 						}
-						// Note: start empty
+						// Note: start empty and public
 						m.quickNote.Reset()
+						m.quickNotePrivate = false
 						m.quickNote.Blur()
 						m.quickMode = true
 
@@ -1156,7 +1791,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						return m, tea.Batch(cmds...)
 					}
 					return m, nil
-				case "s":
+				case key.Matches(msg, m.keys.Status):
 					// Quick status picker for the selected issue
 					filteredIssues := m.getFilteredIssues()
 					if len(filteredIssues) > 0 && m.selectedIndex < len(filteredIssues) {
@@ -1180,14 +1815,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						}
 					}
 					return m, nil
-				case "f":
+				case key.Matches(msg, m.keys.Filter):
 					// Enter filter mode
 					m.filterMode = true
 					m.filterInput.SetValue(m.filterText)
 					m.filterInput.Placeholder = "Type to filter issues..."
 					m.filterInput.Focus()
 					return m, textinput.Blink
-				case "m":
+				case key.Matches(msg, m.keys.ToggleMine):
 					// Toggle view mode: my -> all -> my
 					if m.viewMode == "my" {
 						m.viewMode = "all"
@@ -1200,7 +1835,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						ui.SendLoadingMsg("Fetching issues..."),
 						fetchIssues(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues),
 					)
-				case "r":
+				case key.Matches(msg, m.keys.Reload):
 					// Reload all issues
 					m.loading = true
 					m.selectedIndex = 0
@@ -1209,7 +1844,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						ui.SendLoadingMsg("Reloading issues..."),
 						fetchIssues(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues),
 					)
-				case "u":
+				case key.Matches(msg, m.keys.Users):
 					// Enter user selection mode
 					m.userInputMode = "user"
 					m.listCursor = 0
@@ -1223,7 +1858,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						fetchUsers(m.client),
 						textinput.Blink,
 					)
-				case "p":
+				case key.Matches(msg, m.keys.Projects):
 					// Enter project selection mode
 					m.userInputMode = "project"
 					m.listCursor = 0
@@ -1237,14 +1872,91 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						fetchProjects(m.client),
 						textinput.Blink,
 					)
-				case "?":
+				case key.Matches(msg, m.keys.Mark):
+					// Mark/unmark the selected issue for bulk operations
+					if !m.showModal {
+						m.toggleMark()
+						m.updatePaneContent()
+					}
+					return m, nil
+				case key.Matches(msg, m.keys.MarkRange):
+					// Mark every issue between the last toggled one and the cursor
+					m.markRange()
+					m.updatePaneContent()
+					return m, nil
+				case key.Matches(msg, m.keys.MarkAll):
+					// Mark all filtered issues (or clear if all are marked)
+					m.toggleMarkAll()
+					m.updatePaneContent()
+					return m, nil
+				case key.Matches(msg, m.keys.Board):
+					// Switch to the board view
+					m.discardTimelineShifts()
+					m.timelineMode = false
+					return m, m.openBoard()
+				case key.Matches(msg, m.keys.Roadmap):
+					// Open the versions roadmap
+					return m, m.openRoadmap()
+				case key.Matches(msg, m.keys.Calendar):
+					// Open the month calendar of due dates
+					return m, m.openCalendar()
+				case key.Matches(msg, m.keys.Timeline):
+					// Switch to the timeline view
+					m.boardMode = false
+					return m, m.openTimeline()
+				case key.Matches(msg, m.keys.Undo):
+					// Undo the most recent change made this session
+					return m, m.undoChange(m.lastUndoable())
+				case key.Matches(msg, m.keys.ChangeLog):
+					// Open the session change log to undo a specific change
+					m.openChangeLog()
+					return m, nil
+				case key.Matches(msg, m.keys.History):
+					// Open the selected issue's history with filters and diffs
+					return m, m.openHistory()
+				case key.Matches(msg, m.keys.Copy):
+					// Copy the issue's URL, ID or a link
+					m.openYankMenu()
+					return m, nil
+				case key.Matches(msg, m.keys.Browser):
+					// Open the issue, its project or the query in the browser
+					m.openBrowserMenu()
+					return m, nil
+				case key.Matches(msg, m.keys.Palette):
+					// Command palette
+					return m, m.openPalette()
+				case key.Matches(msg, m.keys.PrevNote, m.keys.NextNote):
+					// Select the previous/next note to reply to
+					delta := 1
+					if key.Matches(msg, m.keys.PrevNote) {
+						delta = -1
+					}
+					m.stepJournal(delta)
+					m.updatePaneContent()
+					if m.journalLine >= 0 {
+						m.rightPane.SetYOffset(m.journalLine)
+					}
+					return m, nil
+				case key.Matches(msg, m.keys.Reply):
+					// Reply to the selected (or latest) note with a quote
+					if issue := m.editingIssue(); issue != nil {
+						if j, ok := m.selectedJournal(); ok {
+							return m, m.replyTo(issue.ID, j)
+						}
+					}
+					return m, nil
+				case key.Matches(msg, m.keys.Notifications):
+					// Open the notification center
+					m.openNotificationCenter()
+					return m, nil
+				case key.Matches(msg, m.keys.Help):
 					m.showModal = !m.showModal
 					if m.showModal {
 						m.modalType = "help"
 						m.modalScroll = 0 // Reset scroll when opening
 					}
 					return m, nil
-				case "tab":
+				case key.Matches(msg, m.keys.SwitchPane):
 					// Switch between panes
 					if m.activePane == 0 {
 						m.activePane = 1
@@ -1,12 +1,23 @@
 package app
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/ktsopanakis/redmine-tui/api"
+	"github.com/ktsopanakis/redmine-tui/config"
 )
 
 func TestInitialModel(t *testing.T) {
@@ -390,3 +401,1731 @@ func TestScrollBounds(t *testing.T) {
 		t.Errorf("selectedIndex %d should be less than issue count %d", model.selectedIndex, len(model.issues))
 	}
 }
+
+// TestFailedSaveKeepsEdits verifies that a rejected save leaves the edit
+// session open with the pending edits intact and the server's validation
+// messages attached to the right fields.
+func TestFailedSaveKeepsEdits(t *testing.T) {
+	model := InitialModel()
+	model.pendingEdits = map[string]string{"subject": "", "due_date": "2020-01-01"}
+	model.originalValues = map[string]string{"subject": "Old", "due_date": ""}
+	model.editedFields = map[string]bool{"subject": true, "due_date": true}
+	model.editingIssueID = 1
+	model.savingEdits = true
+
+	verr := &api.ValidationError{Messages: []string{
+		"Subject cannot be blank",
+		"Due date must be greater than start date",
+		"Something else went wrong",
+	}}
+	updated, _ := model.Update(issueUpdatedMsg{issueID: 1, err: verr})
+	mm := updated.(Model)
+
+	if !mm.editMode {
+		t.Error("editMode should stay on after a failed save")
+	}
+	if mm.pendingEdits["due_date"] != "2020-01-01" {
+		t.Errorf("pendingEdits lost after failed save: %v", mm.pendingEdits)
+	}
+	if mm.saveErrors["subject"] != "Subject cannot be blank" {
+		t.Errorf("subject error = %q", mm.saveErrors["subject"])
+	}
+	if mm.saveErrors["due_date"] == "" {
+		t.Error("due date error should be attributed to the due_date field")
+	}
+	if mm.saveErrors[""] != "Something else went wrong" {
+		t.Errorf("unattributed error = %q", mm.saveErrors[""])
+	}
+}
+
+// TestUnauthorizedPromptsForKey verifies that a 401 from any request, not
+// just the issue list, opens the API key prompt.
+func TestUnauthorizedPromptsForKey(t *testing.T) {
+	unauthorized := &api.APIError{StatusCode: http.StatusUnauthorized}
+	for _, msg := range []tea.Msg{
+		issuesLoadedMsg{err: unauthorized},
+		issueUpdatedMsg{issueID: 1, err: unauthorized},
+		categoriesLoadedMsg{projectID: 1, err: unauthorized},
+		notificationPollMsg{err: unauthorized},
+	} {
+		model := InitialModel()
+		updated, _ := model.Update(msg)
+		if !updated.(Model).apiKeyMode {
+			t.Errorf("%T with a 401 should open the API key prompt", msg)
+		}
+	}
+
+	model := InitialModel()
+	updated, _ := model.Update(categoriesLoadedMsg{projectID: 1, err: &api.APIError{StatusCode: http.StatusNotFound}})
+	if updated.(Model).apiKeyMode {
+		t.Error("other errors shouldn't open the API key prompt")
+	}
+}
+
+// TestStaleDetailIgnored verifies that a detail response for an issue the
+// cursor has already left does not overwrite the list entry.
+func TestStaleDetailIgnored(t *testing.T) {
+	model := InitialModel()
+	model.loading = false
+	model.issues = []api.Issue{{ID: 1, Subject: "One"}, {ID: 2, Subject: "Two"}}
+	model.selectedIndex = 1
+
+	updated, _ := model.Update(issueDetailMsg{issueID: 1, issue: &api.Issue{ID: 1, Subject: "One (stale)"}})
+	mm := updated.(Model)
+	if mm.issues[0].Subject != "One" {
+		t.Errorf("stale detail was applied: subject = %q", mm.issues[0].Subject)
+	}
+
+	updated, _ = mm.Update(issueDetailMsg{issueID: 2, issue: &api.Issue{ID: 2, Subject: "Two (fresh)"}})
+	mm = updated.(Model)
+	if mm.issues[1].Subject != "Two (fresh)" {
+		t.Errorf("detail for the selected issue was dropped: subject = %q", mm.issues[1].Subject)
+	}
+}
+
+// TestDetailCache verifies that navigating onto an issue with current cached
+// details shows them immediately, while an outdated cache entry is not used.
+func TestDetailCache(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"issue":{"id":3,"subject":"Three"}}`))
+	}))
+	defer srv.Close()
+
+	updated := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	model := InitialModel()
+	model.client = api.NewClient(srv.URL, "key")
+	model.loading = false
+	model.issues = []api.Issue{
+		{ID: 1, Subject: "One", UpdatedOn: updated},
+		{ID: 2, Subject: "Two", UpdatedOn: updated},
+		{ID: 3, Subject: "Three", UpdatedOn: updated.Add(time.Hour)},
+	}
+	model.detailCache[2] = api.Issue{ID: 2, Subject: "Two", UpdatedOn: updated, Journals: []api.Journal{{ID: 1, Notes: "cached"}}}
+	model.detailCache[3] = api.Issue{ID: 3, Subject: "Three", UpdatedOn: updated, Journals: []api.Journal{{ID: 2, Notes: "outdated"}}}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, prefetch := m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if got := m.(Model).issues[1].Journals; len(got) != 1 || got[0].Notes != "cached" {
+		t.Errorf("cached journals not applied on navigation: %v", got)
+	}
+	if len(m.(Model).prefetching) == 0 {
+		t.Error("the neighbors of a cached issue should be prefetched")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if got := m.(Model).issues[2].Journals; len(got) != 0 {
+		t.Errorf("outdated cache entry should not be applied, got %v", got)
+	}
+
+	// Moving on cancels the previous selection's prefetches
+	if len(m.(Model).prefetching) != 0 {
+		t.Errorf("prefetches still tracked after moving: %v", m.(Model).prefetching)
+	}
+	var cancelled int
+	var run func(tea.Cmd)
+	run = func(c tea.Cmd) {
+		if c == nil {
+			return
+		}
+		switch msg := c().(type) {
+		case tea.BatchMsg:
+			for _, c := range msg {
+				run(c)
+			}
+		case detailPrefetchedMsg:
+			if errors.Is(msg.err, context.Canceled) {
+				cancelled++
+			}
+		}
+	}
+	run(prefetch)
+	if cancelled == 0 {
+		t.Error("want the stale prefetches cancelled")
+	}
+
+	// A debounce tick from an earlier selection must not trigger a fetch;
+	// the current one does
+	fetches := func(cmd tea.Cmd) int {
+		n := 0
+		var walk func(tea.Cmd)
+		walk = func(c tea.Cmd) {
+			if c == nil {
+				return
+			}
+			switch msg := c().(type) {
+			case tea.BatchMsg:
+				for _, c := range msg {
+					walk(c)
+				}
+			case issueDetailMsg:
+				n++
+			}
+		}
+		walk(cmd)
+		return n
+	}
+	_, cmd := m.Update(detailDebounceMsg{issueID: 2, seq: 0})
+	if n := fetches(cmd); n != 0 {
+		t.Error("stale debounce tick should not fetch details")
+	}
+	_, cmd = m.Update(detailDebounceMsg{issueID: 3, seq: m.(Model).detailSeq})
+	if n := fetches(cmd); n != 1 {
+		t.Errorf("current debounce tick made %d fetches, want 1", n)
+	}
+
+	// The cache drops unlisted issues once it is full
+	mm := m.(Model)
+	for id := 100; id < 100+detailCacheLimit; id++ {
+		mm.cacheDetail(api.Issue{ID: id})
+	}
+	if _, ok := mm.detailCache[2]; !ok || len(mm.detailCache) > detailCacheLimit {
+		t.Errorf("cache holds %d entries, want listed issues kept and the rest bounded", len(mm.detailCache))
+	}
+}
+
+// TestBackgroundRefreshMerge verifies that polled changes are merged without
+// moving the cursor off the selected issue, closed issues drop out and
+// changed ones are flagged as unseen.
+func TestBackgroundRefreshMerge(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	model := InitialModel()
+	model.loading = false
+	model.lastSync = t0
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}, {ID: 5, Name: "Closed", IsClosed: true}}
+	model.issues = []api.Issue{
+		{ID: 1, Subject: "One", UpdatedOn: t0},
+		{ID: 2, Subject: "Two", UpdatedOn: t0},
+		{ID: 3, Subject: "Three", UpdatedOn: t0},
+	}
+	model.selectedIndex = 2 // #3
+
+	updated, _ := model.Update(issueChangesMsg{
+		fetchedAt: t0.Add(time.Minute),
+		issues: []api.Issue{
+			{ID: 1, Subject: "One", Status: api.Status{ID: 5}, UpdatedOn: t0.Add(time.Second)},  // closed
+			{ID: 2, Subject: "Two!", Status: api.Status{ID: 1}, UpdatedOn: t0.Add(time.Second)}, // changed
+			{ID: 3, Subject: "Three", UpdatedOn: t0},                                            // unchanged
+			{ID: 4, Subject: "Four", Status: api.Status{ID: 1}, UpdatedOn: t0.Add(time.Second)}, // new
+		},
+	})
+	mm := updated.(Model)
+
+	if id := mm.selectedIssueID(); id != 3 {
+		t.Errorf("selection moved to #%d, want #3", id)
+	}
+	for _, issue := range mm.issues {
+		if issue.ID == 1 {
+			t.Error("closed issue #1 should have been removed")
+		}
+	}
+	if !mm.unseenUpdates[2] || !mm.unseenUpdates[4] || mm.unseenUpdates[3] {
+		t.Errorf("unseenUpdates = %v, want #2 and #4 only", mm.unseenUpdates)
+	}
+	if mm.unseenCount() != 2 {
+		t.Errorf("unseenCount() = %d, want 2", mm.unseenCount())
+	}
+	if !mm.lastSync.Equal(t0.Add(time.Minute)) {
+		t.Errorf("lastSync = %v, want fetch time", mm.lastSync)
+	}
+
+	// An issue reassigned to someone else is updated but no longer in the
+	// "my" view's results, so it leaves the list
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("issue_id") != "" {
+			_, _ = w.Write([]byte(`{"issues":[{"id":2},{"id":3}],"total_count":2}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"issues":[{"id":3}],"total_count":1}`))
+	}))
+	defer srv.Close()
+	msg := fetchIssueChanges(api.NewClient(srv.URL, "key"), "my", "", "", mm.issues, t0)().(issueChangesMsg)
+	if len(msg.left) != 1 || msg.left[0] != 2 {
+		t.Fatalf("left = %v, want [2]", msg.left)
+	}
+	mm.mergeIssueChanges(msg.issues, msg.left)
+	for _, issue := range mm.issues {
+		if issue.ID == 2 {
+			t.Error("reassigned issue #2 should have been removed")
+		}
+	}
+	if id := mm.selectedIssueID(); id != 3 {
+		t.Errorf("selection moved to #%d, want #3", id)
+	}
+}
+
+// TestNotificationDetection verifies newly assigned issues and mentions
+// are reported once, and my own activity is not.
+func TestNotificationDetection(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	me := api.User{ID: 10, Login: "alice", Name: "Alice"}
+	bob := api.User{ID: 11, Login: "bob", Name: "Bob"}
+
+	model := InitialModel()
+	model.currentUser = &me
+
+	// Baseline: #1 is already mine, nothing is announced
+	model.processNotificationPoll(notificationPollMsg{baseline: true, assigned: []api.Issue{{ID: 1}}})
+	model.notifySync = t0
+
+	later := t0.Add(5 * time.Minute)
+	msg := notificationPollMsg{
+		assigned: []api.Issue{
+			{ID: 1, AssignedTo: &me, UpdatedOn: later},
+			{ID: 2, Subject: "New work", AssignedTo: &me, UpdatedOn: later},
+		},
+		details: []api.Issue{
+			{ID: 2, Journals: []api.Journal{{ID: 100, User: bob, CreatedOn: later,
+				Details: []api.JournalDetail{{Name: "assigned_to_id", NewValue: "10"}}}}},
+			{ID: 3, Journals: []api.Journal{
+				{ID: 101, User: bob, Notes: "ping @Alice please", CreatedOn: later},
+				{ID: 102, User: me, Notes: "my own note", CreatedOn: later},
+			}},
+		},
+	}
+	got := model.processNotificationPoll(msg)
+	if len(got) != 2 {
+		t.Fatalf("got %d notifications, want 2: %+v", len(got), got)
+	}
+	if got[0].IssueID != 2 || !strings.Contains(got[0].Body, "by Bob") {
+		t.Errorf("assignment notification = %+v", got[0])
+	}
+	if got[1].IssueID != 3 || !strings.Contains(got[1].Title, "mentioned you") {
+		t.Errorf("mention notification = %+v", got[1])
+	}
+
+	for text, want := range map[string]bool{
+		"thanks @alice.":     true,
+		"@alice, see this":   true,
+		"ask @alicea":        false,
+		"ask @alice.smith":   false,
+		"ask @alice_2":       false,
+		"@alice-ops, @alice": true,
+	} {
+		if got := mentions(text, "@alice"); got != want {
+			t.Errorf("mentions(%q) = %v, want %v", text, got, want)
+		}
+	}
+
+	// The same poll result again must not repeat anything
+	if again := model.processNotificationPoll(msg); len(again) != 0 {
+		t.Errorf("repeated poll produced %d notifications", len(again))
+	}
+
+	// Only the issues of the latest poll are remembered
+	model.processNotificationPoll(notificationPollMsg{assigned: []api.Issue{{ID: 2, AssignedTo: &me}}})
+	if len(model.knownAssigned) != 1 || !model.knownAssigned[2] || len(model.seenJournals) != 0 {
+		t.Errorf("known = %v, seen journals = %v, want only #2", model.knownAssigned, model.seenJournals)
+	}
+}
+
+func TestBulkUpdate(t *testing.T) {
+	model := InitialModel()
+	model.loading = false
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}, {ID: 3, Name: "Resolved"}}
+	model.availableUsers = []api.User{{ID: 10, Name: "Alice"}}
+	model.availablePriorities = []api.Priority{{ID: 4, Name: "Normal"}}
+	model.projectVersions[1] = []api.Version{}
+	model.issues = []api.Issue{
+		{ID: 1, Subject: "A", Project: api.Project{ID: 1}},
+		{ID: 2, Subject: "B", Project: api.Project{ID: 1}},
+		{ID: 3, Subject: "C", Project: api.Project{ID: 1}},
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	send := func(k tea.KeyMsg) { m, _ = m.Update(k) }
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	// Mark #1 and #3
+	send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	send(runes("j"))
+	send(runes("j"))
+	send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	mm := m.(Model)
+	if got := mm.markedIDs(); len(got) != 2 || got[0] != 1 || got[1] != 3 {
+		t.Fatalf("marked = %v, want [1 3]", got)
+	}
+
+	// 'a' opens the popup for the marked issues with nothing selected
+	send(runes("a"))
+	mm = m.(Model)
+	if !mm.quickMode || len(mm.quickBulkIDs) != 2 {
+		t.Fatalf("quickMode=%v bulkIDs=%v, want bulk popup for 2 issues", mm.quickMode, mm.quickBulkIDs)
+	}
+	if len(mm.quickUpdates()) != 0 {
+		t.Errorf("bulk popup should start with no changes, got %v", mm.quickUpdates())
+	}
+
+	// Pick a status and apply
+	send(tea.KeyMsg{Type: tea.KeyDown})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	mm = m.(Model)
+	if cmd == nil || len(mm.bulkItems) != 2 || mm.modalType != "bulk" {
+		t.Fatalf("ctrl+s should start a bulk update of 2 issues (items=%d modal=%q)", len(mm.bulkItems), mm.modalType)
+	}
+
+	// One success, one failure: only the failure stays marked, and only
+	// the success is logged for undo
+	change := mm.recordChange(1, map[string]interface{}{"status_id": 3})
+	mm.finishBulkItem(bulkItemDoneMsg{issueID: 1, change: change})
+	if cmd := mm.finishBulkItem(bulkItemDoneMsg{issueID: 3, change: mm.recordChange(3, map[string]interface{}{"status_id": 3}), err: errors.New("boom")}); cmd == nil {
+		t.Error("finishing the last item should refresh the list")
+	}
+	if got := mm.markedIDs(); len(got) != 1 || got[0] != 3 {
+		t.Errorf("marked after bulk = %v, want [3]", got)
+	}
+	if len(mm.changeLog) != 1 || mm.changeLog[0].IssueID != 1 {
+		t.Errorf("change log = %+v, want the update of #1", mm.changeLog)
+	}
+}
+
+func TestMarkRange(t *testing.T) {
+	model := InitialModel()
+	model.loading = false
+	model.issues = []api.Issue{{ID: 1, Subject: "A"}, {ID: 2, Subject: "B"}, {ID: 3, Subject: "C"}, {ID: 4, Subject: "D"}}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	send := func(k tea.KeyMsg) { m, _ = m.Update(k) }
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	// Without an anchor a range marks nothing
+	send(runes("j"))
+	send(runes("V"))
+	if mm := m.(Model); len(mm.markedIDs()) != 0 || mm.viewNotice == "" {
+		t.Fatalf("marked = %v without an anchor, want none and a notice", mm.markedIDs())
+	}
+
+	// The anchor is the issue, not its row: it still counts after the
+	// list is reordered
+	send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	mm := m.(Model)
+	mm.issues = []api.Issue{mm.issues[3], mm.issues[2], mm.issues[0], mm.issues[1]}
+	mm.selectedIndex = 0
+	m = mm
+	send(runes("V"))
+	mm = m.(Model)
+	if got := mm.markedIDs(); len(got) != 4 {
+		t.Errorf("marked = %v, want #4 through #2 in the new order", got)
+	}
+
+	// Esc clears the anchor along with the marks
+	send(tea.KeyMsg{Type: tea.KeyEsc})
+	send(runes("V"))
+	if mm := m.(Model); len(mm.markedIDs()) != 0 || mm.markAnchor != 0 {
+		t.Errorf("marked = %v after Esc, want none", mm.markedIDs())
+	}
+}
+
+func TestUndoLastChange(t *testing.T) {
+	var body map[string]map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	model := InitialModel()
+	model.client = api.NewClient(srv.URL, "key")
+	model.availableStatuses = []api.Status{{ID: 2, Name: "In Progress"}, {ID: 3, Name: "Resolved"}}
+	model.issues = []api.Issue{{ID: 8, Subject: "S", Status: api.Status{ID: 2, Name: "In Progress"}}}
+
+	// A note alone cannot be undone
+	if rec := model.recordChange(8, map[string]interface{}{"notes": "hi"}); rec != nil {
+		t.Error("a note-only update should not be recorded")
+	}
+
+	change := model.recordChange(8, map[string]interface{}{"status_id": 3, "notes": "done"})
+	if change == nil {
+		t.Fatal("status change should be recorded")
+	}
+	updated, _ := model.Update(issueUpdatedMsg{issueID: 8, change: change})
+	mm := updated.(Model)
+	if len(mm.changeLog) != 1 {
+		t.Fatalf("change log has %d entries, want 1", len(mm.changeLog))
+	}
+	if got := mm.changeLog[0].summary(); got != "Status: In Progress → Resolved" {
+		t.Errorf("summary = %q", got)
+	}
+
+	// Reverting sends the old status with a note saying so
+	msg := revertChange(mm.client, mm.changeLog[0])().(issueUpdatedMsg)
+	if msg.err != nil || msg.undoOf != mm.changeLog[0].Seq {
+		t.Fatalf("revert msg = %+v", msg)
+	}
+	if got := body["issue"]["status_id"]; got != float64(2) {
+		t.Errorf("reverted status_id = %v, want 2", got)
+	}
+	if note, _ := body["issue"]["notes"].(string); !strings.HasPrefix(note, "Reverted change") {
+		t.Errorf("revert note = %q", note)
+	}
+
+	updated, _ = mm.Update(msg)
+	mm = updated.(Model)
+	if !mm.changeLog[0].Undone || mm.lastUndoable() != -1 {
+		t.Error("reverted change should be marked undone")
+	}
+	if len(mm.changeLog) != 1 {
+		t.Error("a revert should not add its own change log entry")
+	}
+}
+
+func TestBoardMoveCard(t *testing.T) {
+	var updated int
+	var gets []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			updated++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		gets = append(gets, r.URL.Path)
+		// Workflow allows New -> In Progress only
+		_, _ = w.Write([]byte(`{"issue":{"id":1,"allowed_statuses":[{"id":1,"name":"New"},{"id":2,"name":"In Progress"}]}}`))
+	}))
+	defer srv.Close()
+
+	model := InitialModel()
+	model.loading = false
+	model.client = api.NewClient(srv.URL, "key")
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}, {ID: 2, Name: "In Progress"}, {ID: 3, Name: "Resolved"}}
+	model.issues = []api.Issue{
+		{ID: 1, Subject: "A", Status: api.Status{ID: 1, Name: "New"}},
+		{ID: 2, Subject: "B", Status: api.Status{ID: 2, Name: "In Progress"}, AssignedTo: &api.User{ID: 10, Name: "Alice"}},
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+	mm := m.(Model)
+	if !mm.boardMode {
+		t.Fatal("'B' should open the board")
+	}
+	cols := mm.boardColumns()
+	if len(cols) != 3 || len(cols[0].Issues) != 1 || len(cols[2].Issues) != 0 {
+		t.Fatalf("status columns = %+v, want New/In Progress/Resolved incl. empty", cols)
+	}
+	if !strings.Contains(mm.View(), "Resolved (0)") {
+		t.Error("board should render a column for every status")
+	}
+
+	// Move #1 right: the card moves at once and the update is sent
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")})
+	mm = m.(Model)
+	if mm.issues[0].Status.ID != 2 || mm.boardCol != 1 {
+		t.Errorf("card should move to In Progress and stay selected (status=%d col=%d)", mm.issues[0].Status.ID, mm.boardCol)
+	}
+	if cmd == nil {
+		t.Fatal("moving a card should send an update")
+	}
+	if msg := moveIssue(mm.client, 1, map[string]interface{}{"status_id": 2}, "In Progress", nil)(); msg.(issueUpdatedMsg).err != nil || updated != 1 {
+		t.Errorf("allowed move failed: %v", msg.(issueUpdatedMsg).err)
+	}
+
+	// Resolved is not allowed by the workflow: refused without an update
+	msg := moveIssue(mm.client, 1, map[string]interface{}{"status_id": 3}, "Resolved", nil)().(issueUpdatedMsg)
+	if msg.err == nil || updated != 1 {
+		t.Fatalf("disallowed move should be refused before updating (err=%v updates=%d)", msg.err, updated)
+	}
+	m, _ = m.Update(msg)
+	if !strings.Contains(m.(Model).viewNotice, "workflow") {
+		t.Errorf("refused move should be reported, notice = %q", m.(Model).viewNotice)
+	}
+
+	// Regroup by assignee keeps the card selected
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	mm = m.(Model)
+	if mm.boardGroup != "assignee" {
+		t.Fatalf("group = %q, want assignee", mm.boardGroup)
+	}
+	if issue, ok := mm.boardSelected(mm.boardColumns()); !ok || issue.ID != 1 {
+		t.Errorf("selected after regroup = %+v, want #1", issue)
+	}
+
+	// A saved move fetches the issue, not the whole list
+	gets = nil
+	m, cmd = m.Update(issueUpdatedMsg{issueID: 1, local: true})
+	for _, c := range cmd().(tea.BatchMsg) {
+		if c != nil {
+			c()
+		}
+	}
+	if len(gets) != 1 || gets[0] != "/issues/1.json" {
+		t.Errorf("requests after a move = %v, want only the moved issue", gets)
+	}
+
+	// A reload keeps the same card selected
+	m, _ = m.Update(issuesLoadedMsg{issues: []api.Issue{
+		{ID: 3, Subject: "C", Status: api.Status{ID: 1, Name: "New"}},
+		mm.issues[1],
+		mm.issues[0],
+	}})
+	mm = m.(Model)
+	if id := mm.selectedIssueID(); id != 1 {
+		t.Errorf("selected after reload = #%d, want #1", id)
+	}
+	if issue, ok := mm.boardSelected(mm.boardColumns()); !ok || issue.ID != 1 {
+		t.Errorf("board card after reload = %+v, want #1", issue)
+	}
+}
+
+func TestTimelineShiftDates(t *testing.T) {
+	var puts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts = append(puts, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	model := InitialModel()
+	model.client = api.NewClient(srv.URL, "key")
+	model.loading = false
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}}
+	start := today().AddDate(0, 0, 1)
+	model.issues = []api.Issue{
+		{ID: 1, Subject: "A", Status: api.Status{ID: 1}, StartDate: start.Format(dateLayout), DueDate: start.AddDate(0, 0, 3).Format(dateLayout)},
+		{ID: 2, Subject: "B", Status: api.Status{ID: 1}, DueDate: "2000-01-01",
+			Relations: []api.Relation{{ID: 9, IssueID: 1, IssueToID: 2, RelationType: "precedes"}}},
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	key := func(s string) { m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}) }
+	key("t")
+	mm := m.(Model)
+	if !mm.timelineMode {
+		t.Fatal("'t' should open the timeline")
+	}
+	if view := mm.View(); !strings.Contains(view, "█") || !strings.Contains(view, "│") {
+		t.Error("timeline should draw the bar and the today marker")
+	}
+	for _, width := range []int{30, 12, 6} {
+		narrow := mm
+		narrow.issues = append([]api.Issue{{ID: 12345, Subject: "Long subject", Status: api.Status{ID: 1}}}, mm.issues...)
+		narrow.width = width
+		narrow.leftPane.Height = 10
+		if view := narrow.renderTimeline(); view == "" {
+			t.Errorf("timeline at width %d rendered nothing", width)
+		}
+	}
+	if preds := mm.predecessors()[2]; len(preds) != 1 || preds[0] != 1 {
+		t.Errorf("predecessors of #2 = %v, want [1]", preds)
+	}
+
+	// Move the bar two days later, then pull the due date back one
+	key("L")
+	key("L")
+	key("<")
+	mm = m.(Model)
+	if got, want := mm.issues[0].StartDate, start.AddDate(0, 0, 2).Format(dateLayout); got != want {
+		t.Errorf("start = %s, want %s", got, want)
+	}
+	if got, want := mm.issues[0].DueDate, start.AddDate(0, 0, 4).Format(dateLayout); got != want {
+		t.Errorf("due = %s, want %s", got, want)
+	}
+
+	// The start can't pass the due date
+	for i := 0; i < 5; i++ {
+		key("]")
+	}
+	mm = m.(Model)
+	if mm.issues[0].StartDate != mm.issues[0].DueDate || mm.viewNotice == "" {
+		t.Errorf("start should stop at the due date (start=%s due=%s)", mm.issues[0].StartDate, mm.issues[0].DueDate)
+	}
+
+	// Esc discards unsaved shifts
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	mm = m.(Model)
+	if mm.issues[0].StartDate != start.Format(dateLayout) || len(mm.timelinePending) != 0 || !mm.timelineMode {
+		t.Error("Esc should restore the original dates and stay in the timeline")
+	}
+
+	// Ctrl+S sends the shift, recording the original dates for undo
+	key("L")
+	mm = m.(Model)
+	orig := mm.timelinePending[1]
+	change := mm.recordChangeFrom(orig, map[string]interface{}{"start_date": mm.issues[0].StartDate})
+	if change == nil || change.Changes[0].Old != start.Format(dateLayout) {
+		t.Errorf("undo record should hold the original start date, got %+v", change)
+	}
+
+	// A background refresh doesn't overwrite the unsaved shift
+	polled := mm.issues[0]
+	polled.Subject = "A (renamed)"
+	polled.StartDate = start.Format(dateLayout)
+	polled.UpdatedOn = time.Now()
+	mm.mergeIssueChanges([]api.Issue{polled}, nil)
+	if mm.issues[0].Subject != "A (renamed)" || mm.issues[0].StartDate != start.AddDate(0, 0, 1).Format(dateLayout) {
+		t.Errorf("after refresh = %+v, want the new subject with the shifted start", mm.issues[0])
+	}
+	m = mm
+
+	// Shifts of several issues are saved together and reloaded once
+	key("j")
+	key(">")
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if cmd == nil || len(m.(Model).timelinePending) != 0 {
+		t.Fatal("Ctrl+S should send the shifted dates")
+	}
+	var saved timelineSavedMsg
+	for _, c := range cmd().(tea.BatchMsg) {
+		if c == nil {
+			continue
+		}
+		if msg, ok := c().(timelineSavedMsg); ok {
+			saved = msg
+		}
+	}
+	if len(puts) != 2 || len(saved.saved) != 2 || saved.err != nil {
+		t.Fatalf("puts = %v, saved = %+v", puts, saved)
+	}
+	m, _ = m.Update(saved)
+	if mm := m.(Model); len(mm.changeLog) != 2 || !mm.loading {
+		t.Errorf("want both changes logged and one reload, got %d logged", len(mm.changeLog))
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	model := InitialModel()
+	model.loading = false
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}}
+	now := today()
+	model.issues = []api.Issue{
+		{ID: 1, Subject: "Other", Status: api.Status{ID: 1}},
+		{ID: 2, Subject: "Release", Status: api.Status{ID: 1}, DueDate: now.Format(dateLayout)},
+		{ID: 3, Subject: "Later", Status: api.Status{ID: 1}, StartDate: now.Format(dateLayout), DueDate: now.AddDate(0, 1, 0).Format(dateLayout)},
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	key := func(k tea.KeyMsg) { m, _ = m.Update(k) }
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	key(runes("C"))
+	mm := m.(Model)
+	if !mm.showModal || mm.modalType != "calendar" {
+		t.Fatal("'C' should open the calendar")
+	}
+	if view := mm.View(); !strings.Contains(view, "●1") || !strings.Contains(view, now.Format("January 2006")) {
+		t.Error("calendar should show this month with today's due issue")
+	}
+
+	// Toggling to start dates puts #3 on today as well
+	key(runes("s"))
+	mm = m.(Model)
+	if got := mm.calendarIssues()[now.Format(dateLayout)]; len(got) != 1 || got[0].ID != 3 {
+		t.Errorf("start-date issues today = %v, want #3", got)
+	}
+	key(runes("s"))
+
+	// Next month, then back
+	key(runes("]"))
+	if m.(Model).calendarDay.Month() == now.Month() {
+		t.Error("']' should move to the next month")
+	}
+	key(runes("."))
+
+	// Enter lists today's issues, Enter again jumps to the issue
+	key(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.(Model).calendarList {
+		t.Fatal("Enter should list the day's issues")
+	}
+	key(tea.KeyMsg{Type: tea.KeyEnter})
+	mm = m.(Model)
+	if mm.showModal || mm.selectedIndex != 1 {
+		t.Errorf("Enter on an issue should close the calendar and select it (modal=%v selected=%d)", mm.showModal, mm.selectedIndex)
+	}
+}
+
+func TestRoadmapAndTargetVersion(t *testing.T) {
+	model := InitialModel()
+	model.loading = false
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}, {ID: 5, Name: "Closed", IsClosed: true}}
+	model.issues = []api.Issue{
+		{ID: 1, Subject: "A", Project: api.Project{ID: 1}, Status: api.Status{ID: 1}, FixedVersion: &api.Version{ID: 10, Name: "1.0"}},
+		{ID: 2, Subject: "B", Project: api.Project{ID: 1}, Status: api.Status{ID: 1}},
+	}
+	model.projectVersions[1] = []api.Version{
+		{ID: 11, Name: "2.0", Status: "open"},
+		{ID: 10, Name: "1.0", Status: "open", DueDate: "2026-01-01"},
+		{ID: 9, Name: "0.9", Status: "closed", DueDate: "2025-01-01"},
+	}
+
+	// Target version is an editable select of the project's open versions
+	var field EditableField
+	for _, f := range editableFields {
+		if f.Name == "fixed_version_id" {
+			field = f
+		}
+	}
+	if got := field.GetValue(&model.issues[0]); got != "1.0" {
+		t.Errorf("version value = %q, want 1.0", got)
+	}
+	if opts := field.GetOptions(&model); strings.Join(opts, ",") != "(none),2.0,1.0" {
+		t.Errorf("version options = %v", opts)
+	}
+	if u := pendingUpdates(map[string]string{"fixed_version_id": "2.0"}, model); u["fixed_version_id"] != 11 {
+		t.Errorf("version update = %v, want 11", u["fixed_version_id"])
+	}
+	if u := pendingUpdates(map[string]string{"fixed_version_id": noVersion}, model); u["fixed_version_id"] != nil {
+		t.Errorf("clearing the version should send null, got %v", u["fixed_version_id"])
+	}
+
+	// Roadmap: dated versions first, closed ones hidden
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(versionIssuesMsg{versionID: 10, issues: []api.Issue{
+		{ID: 1, Status: api.Status{ID: 1}, DoneRatio: 50},
+		{ID: 3, Status: api.Status{ID: 5}},
+	}})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	mm := m.(Model)
+	if mm.modalType != "roadmap" {
+		t.Fatal("'v' should open the roadmap")
+	}
+	if versions := mm.roadmapVersions(); len(versions) != 2 || versions[0].ID != 10 {
+		t.Fatalf("roadmap versions = %+v, want 1.0 then 2.0", versions)
+	}
+	if open, closed, percent := mm.versionProgress(10); open != 1 || closed != 1 || percent != 75 {
+		t.Errorf("progress = %d open, %d closed, %d%%; want 1, 1, 75%%", open, closed, percent)
+	}
+	if view := mm.View(); !strings.Contains(view, "75%") || !strings.Contains(view, "late") {
+		t.Error("roadmap should show percent done and flag the overdue version")
+	}
+
+	// Drill into 1.0 and open #1
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.(Model).roadmapDrill != 10 {
+		t.Fatal("Enter should list the version's issues")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	mm = m.(Model)
+	if mm.showModal || mm.selectedIndex != 0 {
+		t.Error("Enter on a listed issue should close the roadmap and select it")
+	}
+
+	// Versions with more issues than one page are counted in full
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var issues []string
+		for id := offset + 1; id <= 250 && id <= offset+roadmapPageSize; id++ {
+			issues = append(issues, fmt.Sprintf(`{"id":%d}`, id))
+		}
+		fmt.Fprintf(w, `{"issues":[%s],"total_count":250}`, strings.Join(issues, ","))
+	}))
+	defer srv.Close()
+	msg := fetchVersionIssues(api.NewClient(srv.URL, "key"), 10)().(versionIssuesMsg)
+	if msg.err != nil || len(msg.issues) != 250 {
+		t.Errorf("fetched %d issues (err %v), want all 250", len(msg.issues), msg.err)
+	}
+}
+
+func TestProjectChangeRevalidatesFields(t *testing.T) {
+	model := InitialModel()
+	model.loading = false
+	model.availableProjects = []api.Project{{ID: 1, Name: "Web"}, {ID: 2, Name: "Mobile"}}
+	model.availableTrackers = []api.Tracker{{ID: 1, Name: "Bug"}, {ID: 2, Name: "Feature"}, {ID: 3, Name: "Support"}}
+	model.issues = []api.Issue{{
+		ID: 1, Subject: "A", Project: api.Project{ID: 1, Name: "Web"},
+		Tracker:      api.Tracker{ID: 3, Name: "Support"},
+		Category:     &api.IssueCategory{ID: 4, Name: "UI"},
+		FixedVersion: &api.Version{ID: 10, Name: "1.0"},
+	}}
+	model.projectTrackers[1] = model.availableTrackers
+	model.projectCategories[1] = []api.IssueCategory{{ID: 4, Name: "UI"}}
+	model.projectVersions[1] = []api.Version{{ID: 10, Name: "1.0", Status: "open"}}
+	model.projectVersions[2] = []api.Version{{ID: 20, Name: "2.0", Status: "open"}}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	mm := m.(Model)
+	for mm.editFieldIndex < len(editableFields) && editableFields[mm.editFieldIndex].Name != "project_id" {
+		mm.editFieldIndex++
+	}
+	mm.editInput.SetValue("Web")
+	m = mm
+
+	// Pick the other project and move on: the tracker, category and version
+	// must be re-checked against it once its lists arrive
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	mm = m.(Model)
+	if mm.pendingEdits["project_id"] != "Mobile" {
+		t.Fatalf("project pending = %q, want Mobile", mm.pendingEdits["project_id"])
+	}
+	if mm.editProjectID() != 2 {
+		t.Errorf("edit project = %d, want 2", mm.editProjectID())
+	}
+	if mm.pendingEdits["fixed_version_id"] != noVersion {
+		t.Errorf("version 1.0 isn't in the new project, want it reset; got %q", mm.pendingEdits["fixed_version_id"])
+	}
+	if _, ok := mm.pendingEdits["category_id"]; ok {
+		t.Error("category shouldn't be reset before the project's categories load")
+	}
+
+	// Saving before the lists arrive would send an unchecked tracker/category
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	mm = m.(Model)
+	if !mm.editMode || mm.savingEdits {
+		t.Fatal("the move shouldn't be saved while the new project's lists are loading")
+	}
+	if !strings.HasPrefix(mm.saveErrors[""], projectFieldsWaiting) {
+		t.Errorf("want the refusal shown in the edit view, got %q", mm.saveErrors[""])
+	}
+	if cmd != nil {
+		t.Error("the new project's lists were already requested, they shouldn't be fetched again")
+	}
+
+	m, _ = m.Update(projectTrackersLoadedMsg{projectID: 2, trackers: []api.Tracker{{ID: 1, Name: "Bug"}, {ID: 2, Name: "Feature"}}})
+	m, _ = m.Update(categoriesLoadedMsg{projectID: 2, categories: []api.IssueCategory{{ID: 7, Name: "Backend"}}})
+	mm = m.(Model)
+	if mm.pendingEdits["tracker_id"] != "Bug" {
+		t.Errorf("Support isn't enabled in the new project, want Bug; got %q", mm.pendingEdits["tracker_id"])
+	}
+	if mm.pendingEdits["category_id"] != noCategory {
+		t.Errorf("category = %q, want it cleared", mm.pendingEdits["category_id"])
+	}
+
+	u := pendingUpdates(mm.pendingEdits, mm)
+	if u["project_id"] != 2 || u["tracker_id"] != 1 || u["category_id"] != nil || u["fixed_version_id"] != nil {
+		t.Errorf("updates = %v", u)
+	}
+	if u := pendingUpdates(map[string]string{"start_date": ""}, mm); u["start_date"] != nil {
+		t.Errorf("clearing the start date should send null, got %v", u["start_date"])
+	}
+
+	if mm.saveErrors[""] != "" {
+		t.Errorf("the refusal should clear once the lists load, got %q", mm.saveErrors[""])
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if mm = m.(Model); mm.editMode || !mm.savingEdits {
+		t.Error("once the lists are checked, Ctrl+S should save the move")
+	}
+}
+
+func TestProjectFieldFailureAllowsSave(t *testing.T) {
+	model := InitialModel()
+	model.loading = false
+	model.availableProjects = []api.Project{{ID: 1, Name: "Web"}, {ID: 2, Name: "Mobile"}}
+	model.availableTrackers = []api.Tracker{{ID: 1, Name: "Bug"}}
+	model.issues = []api.Issue{{ID: 1, Subject: "A", Project: api.Project{ID: 1, Name: "Web"}, Tracker: api.Tracker{ID: 1, Name: "Bug"}}}
+	model.projectTrackers[1] = model.availableTrackers
+	model.projectCategories[1] = nil
+	model.projectVersions[1] = nil
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	mm := m.(Model)
+	mm.pendingEdits["project_id"] = "Mobile"
+	cmd := mm.revalidateProjectFields()
+	if cmd == nil || len(cmd().(tea.BatchMsg)) != 6 {
+		t.Fatal("want the project trackers, categories and versions fetched")
+	}
+	if cmd := mm.revalidateProjectFields(); cmd != nil {
+		t.Error("lists in flight shouldn't be requested again")
+	}
+	m = mm
+
+	// A list the user can't read mustn't block the move forever
+	m, _ = m.Update(projectTrackersLoadedMsg{projectID: 2, trackers: model.availableTrackers})
+	m, _ = m.Update(versionsLoadedMsg{projectID: 2})
+	m, _ = m.Update(categoriesLoadedMsg{projectID: 2, err: errors.New("403 Forbidden")})
+	mm = m.(Model)
+	if !strings.Contains(mm.saveErrors[""], "categories") {
+		t.Errorf("want the failed list named, got %q", mm.saveErrors[""])
+	}
+	if cmd := mm.revalidateProjectFields(); cmd != nil {
+		t.Error("a failed list shouldn't be requested again while editing")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if mm = m.(Model); mm.editMode || !mm.savingEdits {
+		t.Error("with the failed list left unchecked, Ctrl+S should save the move")
+	}
+}
+
+func TestEstimatedHours(t *testing.T) {
+	var issue api.Issue
+	data := `{"id": 1, "subject": "A", "estimated_hours": 4, "total_estimated_hours": 6,
+		"spent_hours": 5, "total_spent_hours": 7.5}`
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		t.Fatal(err)
+	}
+	if over := overBudget(issue); over != 1.5 {
+		t.Errorf("over budget by %v, want 1.5 (totals with subtasks)", over)
+	}
+	issue.TotalSpentHours = 6
+	if over := overBudget(issue); over != 0 {
+		t.Errorf("spent within the estimate should not be over budget, got %v", over)
+	}
+
+	model := InitialModel()
+	model.loading = false
+	model.issues = []api.Issue{issue}
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	mm := m.(Model)
+	for editableFields[mm.editFieldIndex].Name != "estimated_hours" {
+		mm.editFieldIndex++
+	}
+	mm.editInput.SetValue("abc")
+	mm.editedFields["estimated_hours"] = true
+	m = mm
+
+	// An invalid number stays on the field with an error
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	mm = m.(Model)
+	if editableFields[mm.editFieldIndex].Name != "estimated_hours" || mm.saveErrors["estimated_hours"] == "" {
+		t.Fatal("an invalid estimate should be rejected and keep the field focused")
+	}
+	if _, ok := mm.pendingEdits["estimated_hours"]; ok {
+		t.Error("an invalid estimate should not be queued")
+	}
+
+	mm.editInput.SetValue("2.5")
+	m = mm
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	mm = m.(Model)
+	if mm.pendingEdits["estimated_hours"] != "2.5" || mm.saveErrors["estimated_hours"] != "" {
+		t.Errorf("valid estimate should be queued, pending %v, errors %v", mm.pendingEdits, mm.saveErrors)
+	}
+	if u := pendingUpdates(mm.pendingEdits, mm); u["estimated_hours"] != 2.5 {
+		t.Errorf("estimated update = %v, want 2.5", u["estimated_hours"])
+	}
+	if u := pendingUpdates(map[string]string{"estimated_hours": ""}, mm); u["estimated_hours"] != nil {
+		t.Errorf("clearing the estimate should send null, got %v", u["estimated_hours"])
+	}
+
+	// Servers that don't report totals send no total_spent_hours
+	model = InitialModel()
+	model.loading = false
+	model.issues = []api.Issue{{ID: 2, Subject: "B", SpentHours: 3}}
+	m = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	if view := m.(Model).View(); !strings.Contains(view, "3h") || strings.Contains(view, "with subtasks") {
+		t.Error("spent hours without a total shouldn't mention subtasks")
+	}
+}
+
+func TestJournalHistory(t *testing.T) {
+	diff := lineDiff("intro\nold step\noutro", "intro\nnew step\noutro\nextra")
+	var ops string
+	for _, l := range diff {
+		ops += string(l.op)
+	}
+	if ops != " -+ +" {
+		t.Errorf("diff ops = %q, want \" -+ +\"", ops)
+	}
+	if added, removed := diffStat(diff); added != 2 || removed != 1 {
+		t.Errorf("diff stat = +%d -%d, want +2 -1", added, removed)
+	}
+
+	// Changes too big for the LCS table show the whole middle as replaced
+	big := strings.Repeat("old\n", 1100) + "end"
+	bigger := strings.Repeat("new\n", 1100) + "end"
+	if added, removed := diffStat(lineDiff(big, bigger)); added != 1100 || removed != 1100 {
+		t.Errorf("capped diff stat = +%d -%d, want +1100 -1100", added, removed)
+	}
+
+	alice := api.User{ID: 1, Name: "Alice"}
+	bob := api.User{ID: 2, Name: "Bob"}
+	model := InitialModel()
+	model.loading = false
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}, {ID: 3, Name: "Resolved"}}
+	model.availablePriorities = []api.Priority{{ID: 2, Name: "Normal"}, {ID: 4, Name: "Urgent"}}
+	model.issues = []api.Issue{{
+		ID: 7, Subject: "A",
+		CustomFields: []api.CustomField{{ID: 5, Name: "Severity", Value: "High"}},
+		Journals: []api.Journal{
+			{ID: 1, User: alice, Details: []api.JournalDetail{
+				{Property: "attr", Name: "status_id", OldValue: "1", NewValue: "3"},
+				{Property: "cf", Name: "5", OldValue: "Low", NewValue: "High"},
+			}},
+			{ID: 2, User: bob, Notes: "Looks good"},
+			{ID: 3, User: alice, Notes: "Reworded", Details: []api.JournalDetail{
+				{Property: "attr", Name: "description", OldValue: "one\ntwo", NewValue: "one\n2"},
+			}},
+		},
+	}}
+
+	issue := model.issues[0]
+	if label, o, n := model.journalDetailText(issue, issue.Journals[0].Details[0]); label != "Status" || o != "New" || n != "Resolved" {
+		t.Errorf("status detail = %q %q → %q", label, o, n)
+	}
+	if label, _, _ := model.journalDetailText(issue, issue.Journals[0].Details[1]); label != "Severity" {
+		t.Errorf("custom field label = %q, want Severity", label)
+	}
+	if label, o, n := model.journalDetailText(issue, api.JournalDetail{Property: "attr", Name: "priority_id", OldValue: "2", NewValue: "4"}); label != "Priority" || o != "Normal" || n != "Urgent" {
+		t.Errorf("priority detail = %q %q → %q", label, o, n)
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	if counts := m.(Model).diffStats[3]; counts != [2]int{1, 1} {
+		t.Errorf("details pane diff counts = %v, want them cached as +1 -1", counts)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	mm := m.(Model)
+	if mm.modalType != "history" || mm.historyCursor != 2 {
+		t.Fatalf("'H' should open the history on the newest entry, got %q at %d", mm.modalType, mm.historyCursor)
+	}
+	if view := mm.View(); !strings.Contains(view, "+ 2") || !strings.Contains(view, "- two") {
+		t.Error("description changes should render as a line diff")
+	}
+
+	// Notes only, then only Bob's
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	mm = m.(Model)
+	if entries := mm.historyEntries(); mm.historyKind != "notes" || len(entries) != 2 {
+		t.Errorf("notes filter = %d entries, want 2", len(entries))
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	mm = m.(Model)
+	if entries := mm.historyEntries(); mm.historyUser != 2 || len(entries) != 1 || entries[0].ID != 2 {
+		t.Errorf("user filter = %+v, want Bob's note only", entries)
+	}
+
+	// Jumping stays within the filtered entries
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	if m.(Model).historyCursor != 0 {
+		t.Error("cursor should stay on the only entry")
+	}
+}
+
+func TestLookupRegistry(t *testing.T) {
+	requests := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/users/123.json":
+			_, _ = w.Write([]byte(`{"user": {"id": 123, "firstname": "Locked", "lastname": "User"}}`))
+		case "/trackers.json":
+			_, _ = w.Write([]byte(`{"trackers": [{"id": 1, "name": "Bug"}, {"id": 7, "name": "Epic"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	model := InitialModel()
+	model.client = api.NewClient(srv.URL, "key")
+	model.loading = false
+	issue := api.Issue{
+		ID: 4, Subject: "A", Tracker: api.Tracker{ID: 1, Name: "Bug"},
+		Journals: []api.Journal{{ID: 1, User: api.User{ID: 2, Name: "Alice"}, Details: []api.JournalDetail{
+			{Property: "attr", Name: "assigned_to_id", OldValue: "2", NewValue: "123"},
+			{Property: "attr", Name: "tracker_id", OldValue: "1", NewValue: "7"},
+			{Property: "attr", Name: "fixed_version_id", NewValue: "99"},
+		}}},
+	}
+	model.issues = []api.Issue{issue}
+
+	// Names carried on issues resolve without any request
+	model.seedLookups(model.issues...)
+	if got := model.lookupName("user", "2"); got != "Alice" {
+		t.Errorf("journal author = %q, want Alice", got)
+	}
+	if got := model.lookupName("tracker", "7"); got != "Tracker #7" {
+		t.Errorf("unknown tracker = %q, want a placeholder", got)
+	}
+
+	// Unknown IDs are fetched once: the tracker list and the single user
+	// and version
+	var m tea.Model = model
+	cmd := model.resolveNames(issue)
+	var run func(cmd tea.Cmd)
+	run = func(cmd tea.Cmd) {
+		if cmd == nil {
+			return
+		}
+		switch msg := cmd().(type) {
+		case tea.BatchMsg:
+			for _, c := range msg {
+				run(c)
+			}
+		default:
+			m, _ = m.Update(msg)
+		}
+	}
+	run(cmd)
+	mm := m.(Model)
+	if got := mm.lookupName("user", "123"); got != "Locked User" {
+		t.Errorf("locked user = %q, want Locked User", got)
+	}
+	if got := mm.lookupName("tracker", "7"); got != "Epic" {
+		t.Errorf("tracker = %q, want Epic", got)
+	}
+	if got := mm.lookupName("version", "99"); got != "Version #99" {
+		t.Errorf("missing version = %q, want the placeholder", got)
+	}
+	if label, _, n := mm.journalDetailText(issue, issue.Journals[0].Details[0]); label != "Assignee" || n != "Locked User" {
+		t.Errorf("journal detail = %q → %q", label, n)
+	}
+
+	run(mm.resolveNames(issue))
+	if requests["/users/123.json"] != 1 || requests["/versions/99.json"] != 1 || requests["/trackers.json"] != 1 {
+		t.Errorf("lookups should be requested once each, got %v", requests)
+	}
+}
+
+func TestPrivateAndOwnNotes(t *testing.T) {
+	bodies := make(map[string]map[string]map[string]interface{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies[r.Method+" "+r.URL.Path] = body
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	me := api.User{ID: 1, Name: "Me"}
+	model := InitialModel()
+	model.client = api.NewClient(srv.URL, "key")
+	model.loading = false
+	model.currentUser = &me
+	model.issues = []api.Issue{{ID: 3, Subject: "A", Journals: []api.Journal{
+		{ID: 4, User: api.User{ID: 2, Name: "Other"}, Notes: "theirs"},
+		{ID: 5, User: me, Notes: "mine", PrivateNotes: true},
+	}}}
+
+	// A private note carries private_notes
+	addNote(model.client, 3, "internal", true)()
+	if got := bodies["PUT /issues/3.json"]["issue"]["private_notes"]; got != true {
+		t.Errorf("private_notes = %v, want true", got)
+	}
+	model.quickNote.SetValue("hi")
+	model.quickNotePrivate = true
+	if u := model.quickUpdates(); u["private_notes"] != true {
+		t.Errorf("quick updates = %v, want a private note", u)
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	if view := m.(Model).View(); !strings.Contains(view, "[private]") {
+		t.Error("private notes should be marked")
+	}
+
+	// Someone else's note can't be edited
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if mm := m.(Model); mm.noteMode || mm.historyNotice == "" {
+		t.Error("editing another user's note should be refused")
+	}
+
+	// Edit my note, making it public
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	mm := m.(Model)
+	if !mm.noteMode || mm.noteJournal != 5 || mm.noteInput.Value() != "mine" || !mm.notePrivate {
+		t.Fatalf("editor state: mode %v journal %d value %q private %v", mm.noteMode, mm.noteJournal, mm.noteInput.Value(), mm.notePrivate)
+	}
+	mm.noteInput.SetValue("mine, reworded")
+	m = mm
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	for _, c := range cmd().(tea.BatchMsg) {
+		if msg, ok := c().(journalUpdatedMsg); ok {
+			m, _ = m.Update(msg)
+		}
+	}
+	journal := bodies["PUT /journals/5.json"]["journal"]
+	if journal["notes"] != "mine, reworded" || journal["private_notes"] != false {
+		t.Errorf("journal update = %v", journal)
+	}
+	if mm := m.(Model); mm.modalType != "history" || mm.historyNotice != "Note updated" {
+		t.Errorf("history should reopen with a notice, got %q %q", mm.modalType, mm.historyNotice)
+	}
+
+	// Deleting asks first
+	delete(bodies, "PUT /journals/5.json")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if m.(Model).historyDelete != 5 {
+		t.Fatal("d should ask to confirm deleting the note")
+	}
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	for _, c := range cmd().(tea.BatchMsg) {
+		c()
+	}
+	if journal := bodies["PUT /journals/5.json"]["journal"]; journal["notes"] != "" {
+		t.Errorf("delete should clear the notes, got %v", journal)
+	}
+}
+
+func TestReplyQuotesNote(t *testing.T) {
+	if got := quoteNotes("first line\nsecond\n\nnext paragraph", "textile"); got != "bq. first line\nsecond\n\nbq. next paragraph" {
+		t.Errorf("textile quote = %q", got)
+	}
+	if got := quoteNotes("first\n\nsecond", "markdown"); got != "> first\n>\n> second" {
+		t.Errorf("markdown quote = %q", got)
+	}
+
+	defer func(f string) { config.Current.Redmine.TextFormatting = f }(config.Current.Redmine.TextFormatting)
+	config.Current.Redmine.TextFormatting = "common_mark"
+
+	model := InitialModel()
+	model.loading = false
+	model.availableUsers = []api.User{{ID: 2, Login: "bob", Name: "Bob"}}
+	model.issues = []api.Issue{{ID: 3, Subject: "A", Journals: []api.Journal{
+		{ID: 4, User: api.User{ID: 2, Name: "Bob"}, Notes: "first"},
+		{ID: 5, User: api.User{ID: 6, Name: "Eve"}, Notes: "second", PrivateNotes: true},
+		{ID: 6, User: api.User{ID: 6, Name: "Eve"}},
+	}}}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	// Without a selection R replies to the latest note
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	mm := m.(Model)
+	if !mm.noteMode || mm.noteIssueID != 3 || !mm.notePrivate || mm.noteInput.Value() != "@Eve wrote:\n\n> second\n\n" {
+		t.Fatalf("reply state: mode %v issue %d private %v value %q", mm.noteMode, mm.noteIssueID, mm.notePrivate, mm.noteInput.Value())
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// [ selects the latest note, [ again the one before, which is Bob's
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	if mm := m.(Model); mm.journalCursor != 4 || mm.journalLine < 0 {
+		t.Fatalf("selected journal %d at line %d, want 4 shown", mm.journalCursor, mm.journalLine)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	if mm := m.(Model); mm.notePrivate || mm.noteInput.Value() != "@bob wrote:\n\n> first\n\n" {
+		t.Errorf("reply to Bob: private %v value %q", mm.notePrivate, mm.noteInput.Value())
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	model := InitialModel()
+	model.loading = false
+	model.availableUsers = []api.User{
+		{ID: 1, Login: "alice", Name: "Alice Smith"},
+		{ID: 2, Login: "bob", Name: "Bob Jones"},
+		{ID: 3, Name: "No Login"},
+	}
+	model.issues = []api.Issue{{ID: 12, Subject: "Login fails"}, {ID: 130, Subject: "Export"}}
+	model.detailCache[7] = api.Issue{ID: 7, Subject: "Recently viewed"}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	typeText := func(s string) {
+		for _, r := range s {
+			m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+
+	// Mentions match login or name and insert the login
+	typeText("thanks @jon")
+	mm := m.(Model)
+	if items := mm.completionItems(); len(items) != 1 || items[0].insert != "@bob" {
+		t.Fatalf("items for @jon = %v", items)
+	}
+	if !strings.Contains(mm.View(), "Bob Jones") {
+		t.Error("suggestions should be shown with names")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if got := m.(Model).noteInput.Value(); got != "thanks @bob " {
+		t.Errorf("after completion = %q", got)
+	}
+
+	// Issues complete by ID or subject, including recently viewed ones
+	typeText("see #")
+	mm = m.(Model)
+	if items := mm.completionItems(); len(items) != 3 {
+		t.Errorf("all issues should be offered, got %v", items)
+	}
+	typeText("rec")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := m.(Model).noteInput.Value(); got != "thanks @bob see #7 " {
+		t.Errorf("after issue completion = %q", got)
+	}
+
+	// Not after a word; Esc closes the list without leaving the editor
+	typeText("mail@al")
+	mm = m.(Model)
+	if items := mm.completionItems(); len(items) != 0 {
+		t.Errorf("e-mail addresses shouldn't complete, got %v", items)
+	}
+	typeText(" @al")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if mm := m.(Model); !mm.noteMode || len(mm.completionItems()) != 0 {
+		t.Error("Esc should only close the suggestions")
+	}
+
+	// A fully typed ID is offered first, and Enter then ends the line
+	// instead of replacing it with a newer issue
+	mm = m.(Model)
+	mm.issues = append(mm.issues, api.Issue{ID: 120, Subject: "Import"})
+	m = mm
+	typeText(" #12")
+	mm = m.(Model)
+	if items := mm.completionItems(); len(items) != 2 || items[0].insert != "#12" {
+		t.Fatalf("items for #12 = %v", items)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := m.(Model).noteInput.Value(); !strings.HasSuffix(got, " #12\n") {
+		t.Errorf("Enter after a complete ID = %q, want a new line", got)
+	}
+}
+
+func TestNoteTemplates(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	path, _ := config.GetTemplatesPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	templates := `templates:
+  - name: Thanks
+    text: "Thanks, {{assignee}}!"
+  - name: Need more info
+    text: "Hi {{author}}, please attach the log for #{{id}} ({{subject}}). -- {{me}}"
+    status: feedback
+    assignee: author
+`
+	if err := os.WriteFile(path, []byte(templates), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var body map[string]map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	model := InitialModel()
+	model.client = api.NewClient(srv.URL, "key")
+	model.loading = false
+	model.currentUser = &api.User{ID: 1, Name: "Me"}
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}, {ID: 4, Name: "Feedback"}}
+	model.availableUsers = []api.User{{ID: 1, Name: "Me"}, {ID: 9, Name: "Reporter"}}
+	model.issues = []api.Issue{{ID: 3, Subject: "Crash", Author: api.User{ID: 9, Name: "Reporter"},
+		AssignedTo: &api.User{ID: 1, Name: "Me"}, Status: api.Status{ID: 1, Name: "New"}}}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if view := m.(Model).View(); !strings.Contains(view, "Need more info") {
+		t.Fatal("the picker should list the templates")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	mm := m.(Model)
+	if want := "Hi Reporter, please attach the log for #3 (Crash). -- Me"; mm.noteInput.Value() != want {
+		t.Errorf("note = %q, want %q", mm.noteInput.Value(), want)
+	}
+	if mm.noteUpdates["status_id"] != 4 || mm.noteUpdates["assigned_to_id"] != 9 {
+		t.Errorf("note updates = %v", mm.noteUpdates)
+	}
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	for _, c := range cmd().(tea.BatchMsg) {
+		if msg, ok := c().(issueUpdatedMsg); ok {
+			m, _ = m.Update(msg)
+		}
+	}
+	issue := body["issue"]
+	if issue["status_id"] != float64(4) || issue["assigned_to_id"] != float64(9) || !strings.HasPrefix(issue["notes"].(string), "Hi Reporter") {
+		t.Errorf("posted %v", issue)
+	}
+
+	// In the quick-actions popup a template selects the status and assignee
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	for i := 0; i < 2; i++ { // status, assignee, note
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	mm = m.(Model)
+	if u := mm.quickUpdates(); u["status_id"] != 4 || u["assigned_to_id"] != 9 {
+		t.Errorf("quick updates = %v", u)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Cancelling the note drops the template's changes with it
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if mm = m.(Model); mm.noteMode || mm.noteUpdates != nil {
+		t.Errorf("after Esc noteMode=%v updates=%v, want both cleared", mm.noteMode, mm.noteUpdates)
+	}
+
+	// An edited note can't carry field changes, so the template says so
+	mm.historyIssueID = 3
+	mm.editHistoryNote(api.Journal{ID: 50, Notes: "old"})
+	m = mm
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if mm = m.(Model); len(mm.noteUpdates) != 0 || mm.templateNotice == "" {
+		t.Errorf("editing a note: updates=%v notice=%q, want none and a notice", mm.noteUpdates, mm.templateNotice)
+	}
+}
+
+func TestYankMenu(t *testing.T) {
+	model := InitialModel()
+	model.client = api.NewClient("https://redmine.example.com/", "key")
+	model.loading = false
+	model.issues = []api.Issue{{ID: 42, Subject: "Fix login"}}
+
+	mm := &model
+	want := []string{
+		"https://redmine.example.com/issues/42",
+		"#42",
+		"#42: Fix login",
+		"[#42: Fix login](https://redmine.example.com/issues/42)",
+	}
+	for i, o := range mm.yankOptions(model.issues[0]) {
+		if o.text != want[i] {
+			t.Errorf("option %d = %q, want %q", i, o.text, want[i])
+		}
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if view := m.(Model).View(); !strings.Contains(view, "Markdown link") {
+		t.Fatal("the yank menu should be shown")
+	}
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	if cmd == nil || m.(Model).showModal {
+		t.Fatal("picking an option should close the menu and copy")
+	}
+
+	m, _ = m.Update(yankedMsg{text: "#42: Fix login", via: "terminal"})
+	if view := m.(Model).View(); !strings.Contains(view, "Copied #42: Fix login") {
+		t.Error("the footer should confirm the copy")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if m.(Model).viewNotice != "" {
+		t.Error("the confirmation should clear on the next key")
+	}
+}
+
+func TestOpenInBrowser(t *testing.T) {
+	defer func(c string) { config.Current.Browser.Command = c }(config.Current.Browser.Command)
+
+	model := InitialModel()
+	model.client = api.NewClient("https://redmine.example.com", "key")
+	model.loading = false
+	model.viewMode = "all"
+	model.assigneeFilter = "4,7"
+	model.projectFilter = "2"
+	model.issues = []api.Issue{{ID: 42, Subject: "Fix login", Project: api.Project{ID: 2, Name: "Web"}, AssignedTo: &api.User{ID: 4}}}
+
+	mm := &model
+	if got, want := mm.queryURL(), "https://redmine.example.com/issues?assigned_to_id=4%7C7&project_id=2&set_filter=1&status_id=o"; got != want {
+		t.Errorf("query URL = %s, want %s", got, want)
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if view := m.(Model).View(); !strings.Contains(view, "Project Web") {
+		t.Fatal("the open menu should list the project")
+	}
+
+	// A configured command gets the URL; a missing one shows the URL instead
+	config.Current.Browser.Command = "true"
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m, _ = m.Update(cmd())
+	if mm := m.(Model); mm.showModal || !strings.Contains(mm.viewNotice, "/issues/42") {
+		t.Errorf("opening should be confirmed, notice = %q", mm.viewNotice)
+	}
+
+	config.Current.Browser.Command = "no-such-browser-here"
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m, _ = m.Update(cmd())
+	if view := m.(Model).View(); !strings.Contains(view, "redmine.example.com/projects/2") {
+		t.Error("the URL should be shown when no browser can be started")
+	}
+}
+
+func TestCommandPalette(t *testing.T) {
+	bodies := map[string]map[string]map[string]interface{}{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"issue": {"id": 77, "subject": "Elsewhere", "project": {"id": 1, "name": "Web"}}}`))
+			return
+		}
+		var body map[string]map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies[r.Method+" "+r.URL.Path] = body
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	model := InitialModel()
+	model.client = api.NewClient(srv.URL, "key")
+	model.loading = false
+	model.viewMode = "all"
+	model.availableStatuses = []api.Status{{ID: 1, Name: "New"}, {ID: 3, Name: "Resolved"}, {ID: 5, Name: "Rejected"}}
+	model.availableUsers = []api.User{{ID: 10, Login: "bob", Name: "Bob Smith"}, {ID: 11, Login: "bobby", Name: "Bobby Tables"}}
+	model.issues = []api.Issue{
+		{ID: 1, Subject: "A", Project: api.Project{ID: 1}},
+		{ID: 2, Subject: "B", Project: api.Project{ID: 1}},
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+	palette := func(input string) tea.Cmd {
+		m, _ = m.Update(runes(":"))
+		var cmd tea.Cmd
+		for _, r := range input {
+			m, _ = m.Update(runes(string(r)))
+		}
+		m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		return cmd
+	}
+	run := func(cmd tea.Cmd) {
+		var exec func(tea.Cmd)
+		exec = func(c tea.Cmd) {
+			if c == nil {
+				return
+			}
+			switch msg := c().(type) {
+			case tea.BatchMsg:
+				for _, c := range msg {
+					exec(c)
+				}
+			default:
+				m, _ = m.Update(msg)
+			}
+		}
+		exec(cmd)
+	}
+
+	// Fuzzy matching ranks name prefixes first, then spread-out letters
+	m, _ = m.Update(runes(":"))
+	for _, r := range "tl" {
+		m, _ = m.Update(runes(string(r)))
+	}
+	mm := m.(Model)
+	if items := mm.paletteItems(); len(items) == 0 || paletteCommands()[items[0].ID].name != "timeline" {
+		t.Fatalf("\"tl\" should rank timeline first, got %v", items)
+	}
+	if view := mm.View(); !strings.Contains(view, "Timeline view") {
+		t.Error("the palette should list the matching commands")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.(Model).paletteMode {
+		t.Fatal("Esc should close the palette")
+	}
+
+	// A command without arguments presses its key
+	palette("calend")
+	if mm := m.(Model); mm.modalType != "calendar" {
+		t.Errorf("running calendar should open it, modal = %q", mm.modalType)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// goto selects a listed issue, or fetches one that isn't loaded
+	palette("goto 2")
+	if mm := m.(Model); mm.selectedIssueID() != 2 {
+		t.Errorf("goto 2 selected #%d", mm.selectedIssueID())
+	}
+	run(palette("goto #77"))
+	if mm := m.(Model); mm.selectedIssueID() != 77 {
+		t.Errorf("goto 77 should fetch and select it, selected #%d (%s)", mm.selectedIssueID(), mm.viewNotice)
+	}
+
+	// status and assign take a name; ambiguous names are reported
+	run(palette("status res"))
+	if got := bodies["PUT /issues/77.json"]["issue"]["status_id"]; got != float64(3) {
+		t.Errorf("status_id = %v, want 3", got)
+	}
+	palette("assign @bo")
+	if mm := m.(Model); !strings.Contains(mm.viewNotice, "matches 2 users") {
+		t.Errorf("an ambiguous user should be reported, notice = %q", mm.viewNotice)
+	}
+	run(palette("assign @bob"))
+	if got := bodies["PUT /issues/77.json"]["issue"]["assigned_to_id"]; got != float64(10) {
+		t.Errorf("assigned_to_id = %v, want 10", got)
+	}
+}
+
+func TestKeyBindings(t *testing.T) {
+	defer func(keys map[string]config.KeyList) { config.Current.Keys = keys }(config.Current.Keys)
+
+	// The defaults don't conflict
+	if _, problems := newKeyMap(nil); len(problems) != 0 {
+		t.Fatalf("default bindings have problems: %v", problems)
+	}
+
+	config.Current.Keys = map[string]config.KeyList{
+		"reload":   {"Ctrl+R"},
+		"timeline": {"t", "ctrl+r"},
+		"history":  {"enter"},
+		"frobnish": {"x"},
+		"mark":     {"space", "x"},
+	}
+	model := InitialModel()
+	model.loading = false
+	model.viewMode = "all"
+	model.issues = []api.Issue{{ID: 1, Subject: "A"}, {ID: 2, Subject: "B"}, {ID: 3, Subject: "C"}}
+	problems := strings.Join(model.keyProblems, "\n")
+	for _, want := range []string{"keys.frobnish: unknown action", "Ctrl+R is bound to reload, timeline", "Enter is reserved"} {
+		if !strings.Contains(problems, want) {
+			t.Errorf("problems should mention %q, got:\n%s", want, problems)
+		}
+	}
+	if !model.showModal || model.modalType != "keys" {
+		t.Error("problems should be reported at startup")
+	}
+
+	var m tea.Model = model
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	if view := m.(Model).View(); !strings.Contains(view, "keys.frobnish") {
+		t.Error("the startup modal should list the problems")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// The help and footer follow the bindings
+	mm := m.(Model)
+	if help := strings.Join(mm.getHelpContent(), "\n"); !strings.Contains(help, "Ctrl+R         - Reload") || !strings.Contains(help, "Space/x        - Mark") {
+		t.Errorf("help should show the rebound keys:\n%s", help)
+	}
+	footer := false
+	for _, item := range mm.getFooterItems() {
+		footer = footer || item.Text == "Ctrl+R: Reload"
+	}
+	if !footer {
+		t.Error("the footer should show the rebound reload key")
+	}
+
+	// The old key no longer reloads; the new one does
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if m.(Model).loading {
+		t.Error("r should no longer reload")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if !m.(Model).markedIssues[1] {
+		t.Error("x should mark the issue")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if !m.(Model).loading {
+		t.Error("ctrl+r should reload")
+	}
+	m, _ = m.Update(issuesLoadedMsg{issues: model.issues})
+
+	// Home and End jump to the first and last issue
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	if mm := m.(Model); mm.selectedIssueID() != 3 {
+		t.Errorf("End selected #%d, want #3", mm.selectedIssueID())
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyHome})
+	if mm := m.(Model); mm.selectedIssueID() != 1 {
+		t.Errorf("Home selected #%d, want #1", mm.selectedIssueID())
+	}
+}
@@ -0,0 +1,397 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	"github.com/ktsopanakis/redmine-tui/config"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// maxNotifications caps the notification center history
+const maxNotifications = 100
+
+// notificationConcurrency bounds the journal fetches of one poll
+const notificationConcurrency = 4
+
+// notification is one entry in the in-app notification center
+type notification struct {
+	IssueID int
+	Title   string
+	Body    string
+	At      time.Time
+	Read    bool
+}
+
+// notifyTickMsg triggers a notification poll
+type notifyTickMsg struct{}
+
+// notificationInterval is how often notifications are polled: their own
+// interval if set, otherwise the background refresh interval (0 = never)
+func notificationInterval() time.Duration {
+	if interval := config.Current.Notifications.Interval; interval > 0 {
+		return interval
+	}
+	return config.Current.Refresh.Interval
+}
+
+// notifyTickCmd schedules the next notification poll, if polling is enabled
+func notifyTickCmd() tea.Cmd {
+	interval := notificationInterval()
+	if interval <= 0 {
+		return nil
+	}
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return notifyTickMsg{}
+	})
+}
+
+// notificationPollMsg carries what changed for the current user since the
+// last notification poll. On the first poll (baseline) only the currently
+// assigned issues are reported, so nothing pre-existing is announced.
+type notificationPollMsg struct {
+	baseline  bool
+	since     time.Time   // start of the polled window
+	assigned  []api.Issue // open issues assigned to me
+	details   []api.Issue // assigned or watched issues updated since, with journals
+	fetchedAt time.Time
+	err       error
+}
+
+// pollNotifications queries the open issues assigned to the current user
+// and the watched issues that changed since the last poll, and fetches the
+// journals of those that changed (a few at a time; the issue list can't
+// include journals).
+func pollNotifications(client *api.Client, since time.Time) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		fetchedAt := time.Now()
+
+		assigned, err := client.GetIssuesQuery(ctx, api.IssueQuery{AssignedTo: "me", StatusID: "open", Sort: "updated_on:desc", Limit: 100})
+		if err != nil {
+			return notificationPollMsg{err: err}
+		}
+		if since.IsZero() {
+			return notificationPollMsg{baseline: true, assigned: assigned.Issues, fetchedAt: fetchedAt}
+		}
+
+		since = since.Add(-syncOverlap)
+		watched, err := client.GetIssuesQuery(ctx, api.IssueQuery{
+			WatcherID: "me", StatusID: "*", UpdatedSince: since, Sort: "updated_on:desc", Limit: 25,
+		})
+		if err != nil {
+			return notificationPollMsg{err: err}
+		}
+
+		// Fetch journals for every changed issue (assigned and watched, once each)
+		seen := make(map[int]bool)
+		var ids []int
+		for _, issue := range append(assigned.Issues, watched.Issues...) {
+			if seen[issue.ID] || issue.UpdatedOn.Before(since) {
+				continue
+			}
+			seen[issue.ID] = true
+			ids = append(ids, issue.ID)
+		}
+		fetched := make([]*api.Issue, len(ids))
+		sem := make(chan struct{}, notificationConcurrency)
+		var wg sync.WaitGroup
+		for i, id := range ids {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				if full, err := client.GetIssueContext(ctx, id); err == nil {
+					fetched[i] = full
+				}
+			}()
+		}
+		wg.Wait()
+		var details []api.Issue
+		for _, full := range fetched {
+			if full != nil {
+				details = append(details, *full)
+			}
+		}
+		return notificationPollMsg{since: since, assigned: assigned.Issues, details: details, fetchedAt: fetchedAt}
+	}
+}
+
+// processNotificationPoll turns a poll result into new notifications:
+// issues newly assigned to me, and journals by others on my assigned or
+// watched issues (flagged as mentions when they contain @my-login).
+//
+// Only the issues in the poll are remembered afterwards: knownAssigned is
+// replaced by the currently assigned issues, and seenJournals by the
+// journals of the changed ones (older journals are skipped by date anyway).
+func (m *Model) processNotificationPoll(msg notificationPollMsg) []notification {
+	known := m.knownAssigned
+	m.knownAssigned = make(map[int]bool)
+	for _, issue := range msg.assigned {
+		m.knownAssigned[issue.ID] = true
+	}
+	if msg.baseline {
+		return nil
+	}
+	seenJournals := m.seenJournals
+	m.seenJournals = make(map[int]bool)
+
+	myID, myLogin := 0, ""
+	if m.currentUser != nil {
+		myID, myLogin = m.currentUser.ID, m.currentUser.Login
+	}
+	detailsByID := make(map[int]api.Issue)
+	for _, issue := range msg.details {
+		detailsByID[issue.ID] = issue
+	}
+
+	var out []notification
+	newlyAssigned := make(map[int]bool)
+	for _, issue := range msg.assigned {
+		// An assignment updates the issue, so older ones were known before
+		if issue.AssignedTo == nil || issue.AssignedTo.ID != myID || known[issue.ID] || issue.UpdatedOn.Before(msg.since) {
+			continue
+		}
+		by := assignedBy(detailsByID[issue.ID], myID)
+		if by != nil && by.ID == myID {
+			continue // I assigned it to myself
+		}
+		body := issue.Subject
+		if by != nil {
+			body = fmt.Sprintf("%s (by %s)", issue.Subject, by.Name)
+		}
+		out = append(out, notification{
+			IssueID: issue.ID,
+			Title:   fmt.Sprintf("Assigned to you: #%d", issue.ID),
+			Body:    body,
+			At:      issue.UpdatedOn,
+		})
+		newlyAssigned[issue.ID] = true
+	}
+
+	mention := ""
+	if myLogin != "" {
+		mention = "@" + strings.ToLower(myLogin)
+	}
+	for _, issue := range msg.details {
+		for _, j := range issue.Journals {
+			m.seenJournals[j.ID] = true
+			if seenJournals[j.ID] {
+				continue
+			}
+			if j.User.ID == myID || j.CreatedOn.Before(m.notifySync.Add(-syncOverlap)) {
+				continue
+			}
+			switch {
+			case mention != "" && mentions(strings.ToLower(j.Notes), mention):
+				out = append(out, notification{
+					IssueID: issue.ID,
+					Title:   fmt.Sprintf("%s mentioned you on #%d", j.User.Name, issue.ID),
+					Body:    excerpt(j.Notes, 120),
+					At:      j.CreatedOn,
+				})
+			case newlyAssigned[issue.ID] && j.Notes == "":
+				// Already announced as an assignment
+			default:
+				body := excerpt(j.Notes, 120)
+				if body == "" {
+					body = issue.Subject
+				}
+				out = append(out, notification{
+					IssueID: issue.ID,
+					Title:   fmt.Sprintf("%s updated #%d", j.User.Name, issue.ID),
+					Body:    body,
+					At:      j.CreatedOn,
+				})
+			}
+		}
+	}
+	return out
+}
+
+// assignedBy finds who most recently assigned the issue to userID, if the
+// journals say so.
+func assignedBy(issue api.Issue, userID int) *api.User {
+	want := strconv.Itoa(userID)
+	for i := len(issue.Journals) - 1; i >= 0; i-- {
+		j := issue.Journals[i]
+		for _, d := range j.Details {
+			if d.Name == "assigned_to_id" && d.NewValue == want {
+				return &j.User
+			}
+		}
+	}
+	return nil
+}
+
+// mentions reports whether text contains mention ("@login") as a whole
+// word, so "@bobby" and "@bob.smith" don't count for @bob. A trailing
+// full stop still ends the word.
+func mentions(text, mention string) bool {
+	loginRune := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+	}
+	for i := 0; ; {
+		at := strings.Index(text[i:], mention)
+		if at < 0 {
+			return false
+		}
+		i += at + len(mention)
+		next := []rune(text[i:])
+		if len(next) > 0 && next[0] == '.' {
+			next = next[1:] // "@bob." ends a sentence, "@bob.smith" is another login
+		}
+		if len(next) == 0 || !loginRune(next[0]) {
+			return true
+		}
+	}
+}
+
+// excerpt flattens text to a single line and shortens it to n runes
+func excerpt(text string, n int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if r := []rune(text); len(r) > n {
+		return string(r[:n-1]) + "…"
+	}
+	return text
+}
+
+// addNotifications stores new notifications (newest first) and hands each
+// to the configured notifier command, if any.
+func (m *Model) addNotifications(ns []notification) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, n := range ns {
+		m.notifications = append([]notification{n}, m.notifications...)
+		cmds = append(cmds, runNotifier(config.Current.Notifications.Command, n))
+	}
+	if len(m.notifications) > maxNotifications {
+		m.notifications = m.notifications[:maxNotifications]
+	}
+	return tea.Batch(cmds...)
+}
+
+// runNotifier passes a notification to an external command such as
+// notify-send, with the title and body appended as arguments. Failures are
+// ignored; the notification center still has the entry.
+func runNotifier(command string, n notification) tea.Cmd {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		args := append(append([]string{}, fields[1:]...), n.Title, n.Body)
+		_ = exec.Command(fields[0], args...).Run()
+		return nil
+	}
+}
+
+// unreadNotifications returns the number of unread notifications
+func (m *Model) unreadNotifications() int {
+	n := 0
+	for _, note := range m.notifications {
+		if !note.Read {
+			n++
+		}
+	}
+	return n
+}
+
+// openNotificationCenter shows the notification list and marks all read
+func (m *Model) openNotificationCenter() {
+	m.showModal = true
+	m.modalType = "notifications"
+	m.modalScroll = 0
+	m.notifyCursor = 0
+	for i := range m.notifications {
+		m.notifications[i].Read = true
+	}
+}
+
+// updateNotificationCenter handles keys while the notification list is open.
+// Enter jumps to the notification's issue if it is in the current list.
+func (m Model) updateNotificationCenter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "n", "q":
+		m.showModal = false
+		m.modalType = ""
+		return m, nil
+	case "up", "k":
+		if m.notifyCursor > 0 {
+			m.notifyCursor--
+		}
+	case "down", "j":
+		if m.notifyCursor < len(m.notifications)-1 {
+			m.notifyCursor++
+		}
+	case "enter":
+		if m.notifyCursor >= len(m.notifications) {
+			return m, nil
+		}
+		issueID := m.notifications[m.notifyCursor].IssueID
+		for i, issue := range m.getFilteredIssues() {
+			if issue.ID == issueID {
+				m.showModal = false
+				m.modalType = ""
+				m.selectedIndex = i
+				m.updatePaneContent()
+				return m, m.loadDetail(issueID)
+			}
+		}
+	}
+	return m, nil
+}
+
+// renderNotificationCenter renders the notification list as a modal
+func (m Model) renderNotificationCenter() string {
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Title)).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+
+	var lines []string
+	if len(m.notifications) == 0 {
+		lines = append(lines, dimStyle.Render("No notifications yet."))
+		if notificationInterval() <= 0 {
+			lines = append(lines, "", dimStyle.Render("Set notifications.interval (or refresh.interval) in config.yaml to enable polling."))
+		}
+	}
+	// Two lines per entry; keep the cursor in view
+	scroll := 0
+	if visible := 10; m.notifyCursor >= visible {
+		scroll = m.notifyCursor - visible + 1
+	}
+	for i, n := range m.notifications {
+		if i < scroll {
+			continue
+		}
+		prefix := "  "
+		title := titleStyle.Render(n.Title)
+		if i == m.notifyCursor {
+			prefix = "→ "
+			title = cursorStyle.Render(n.Title)
+		}
+		lines = append(lines,
+			prefix+title+" "+dimStyle.Render(n.At.Local().Format("01-02 15:04")),
+			"    "+excerpt(n.Body, 54),
+		)
+	}
+
+	return appui.RenderModal(appui.ModalConfig{
+		Title:       "Notifications (Enter: open issue, Esc: close)",
+		Content:     lines,
+		Width:       m.width,
+		Height:      m.height,
+		BorderColor: appui.Theme.Warning,
+		TitleColor:  appui.Theme.Title,
+	})
+}
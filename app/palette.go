@@ -0,0 +1,401 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// The command palette (":" or Ctrl+P) lists the actions of the list view
+// with their keys. Typing filters them fuzzily; Enter runs the selected one.
+// Most actions just press their key; a few take an argument after the
+// command name, e.g. ":goto 1234", ":assign @bob" or ":status Resolved".
+
+// paletteCommand is an action offered by the palette
+type paletteCommand struct {
+	name   string
+	action string // key map action it runs ("" = palette only)
+	desc   string
+	arg    string // argument placeholder shown in the list, if it takes one
+	// run executes the command with its argument; nil means press key
+	run func(m *Model, arg string) (tea.Model, tea.Cmd)
+}
+
+// paletteCommands lists every palette action, in the order shown when
+// nothing is typed. (A function, as the commands refer back to Update.)
+func paletteCommands() []paletteCommand {
+	return []paletteCommand{
+		{name: "goto", desc: "Jump to an issue by ID", arg: "<id>", run: (*Model).paletteGoto},
+		{name: "assign", action: "actions", desc: "Assign the selected/marked issues", arg: "[@user|me|nobody]", run: (*Model).paletteAssign},
+		{name: "status", action: "status", desc: "Set the status of the selected/marked issues", arg: "[status]", run: (*Model).paletteStatus},
+		{name: "edit", action: "edit", desc: "Edit the selected issue"},
+		{name: "note", action: "note", desc: "Add a note to the selected issue"},
+		{name: "reply", action: "reply", desc: "Reply to the selected note, quoting it"},
+		{name: "actions", action: "actions", desc: "Quick actions: status, assignee and note"},
+		{name: "history", action: "history", desc: "History of the selected issue"},
+		{name: "copy", action: "copy", desc: "Copy the issue URL, ID or a link"},
+		{name: "browser", action: "browser", desc: "Open the issue, project or query in the browser"},
+		{name: "filter", action: "filter", desc: "Filter the list by text"},
+		{name: "mine", action: "toggle_mine", desc: "Toggle my issues / all issues"},
+		{name: "users", action: "users", desc: "Filter by assignees"},
+		{name: "projects", action: "projects", desc: "Filter by projects"},
+		{name: "reload", action: "reload", desc: "Reload issues from the server"},
+		{name: "mark", action: "mark", desc: "Mark/unmark the selected issue"},
+		{name: "mark-all", action: "mark_all", desc: "Mark all listed issues (again to clear)"},
+		{name: "undo", action: "undo", desc: "Undo the last change"},
+		{name: "changes", action: "change_log", desc: "Change log: pick a change to undo"},
+		{name: "notifications", action: "notifications", desc: "Notification center"},
+		{name: "board", action: "board", desc: "Board view"},
+		{name: "timeline", action: "timeline", desc: "Timeline view"},
+		{name: "calendar", action: "calendar", desc: "Calendar of due dates"},
+		{name: "roadmap", action: "roadmap", desc: "Roadmap of versions"},
+		{name: "help", action: "help", desc: "Show the help"},
+		{name: "quit", action: "quit", desc: "Quit"},
+	}
+}
+
+// gotoIssueMsg carries an issue fetched for ":goto" because it wasn't loaded
+type gotoIssueMsg struct {
+	issueID int
+	issue   *api.Issue
+	err     error
+}
+
+// openPalette shows the command palette and loads the lists its arguments
+// are resolved against
+func (m *Model) openPalette() tea.Cmd {
+	m.paletteMode = true
+	m.paletteCursor = 0
+	m.paletteInput.SetValue("")
+	cmds := []tea.Cmd{m.paletteInput.Focus()}
+	if len(m.availableUsers) == 0 && !m.lookupFetching[lookupKey("user", 0)] {
+		m.lookupFetching[lookupKey("user", 0)] = true
+		cmds = append(cmds, appui.SendLoadingMsg("Fetching users..."), fetchUsers(m.client))
+	}
+	if len(m.availableStatuses) == 0 && !m.lookupFetching[lookupKey("status", 0)] {
+		m.lookupFetching[lookupKey("status", 0)] = true
+		cmds = append(cmds, appui.SendLoadingMsg("Fetching statuses..."), fetchStatuses(m.client))
+	}
+	return tea.Batch(cmds...)
+}
+
+// paletteQuery splits the input into the command query and its argument
+func (m *Model) paletteQuery() (query, arg string) {
+	query, arg, _ = strings.Cut(strings.TrimLeft(m.paletteInput.Value(), " :"), " ")
+	return query, strings.TrimSpace(arg)
+}
+
+// fuzzyScore scores how well query matches text: its letters must appear in
+// order. Prefix matches score best, then substrings, then spread-out letters
+// (fewer gaps first). ok is false if query doesn't match.
+func fuzzyScore(text, query string) (score int, ok bool) {
+	text, query = strings.ToLower(text), strings.ToLower(query)
+	if query == "" {
+		return 0, true
+	}
+	if strings.HasPrefix(text, query) {
+		return 3000 - len(text), true
+	}
+	if i := strings.Index(text, query); i >= 0 {
+		return 2000 - i, true
+	}
+	gaps, last := 0, -1
+	rest := []rune(text)
+	pos := 0
+	for _, q := range query {
+		found := false
+		for ; pos < len(rest); pos++ {
+			if rest[pos] == q {
+				if last >= 0 && pos != last+1 {
+					gaps++
+				}
+				last = pos
+				pos++
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return 1000 - gaps, true
+}
+
+// paletteScore matches a command by name first, then by its description
+func paletteScore(c paletteCommand, query string) (int, bool) {
+	if score, ok := fuzzyScore(c.name, query); ok {
+		return score + 10000, true
+	}
+	return fuzzyScore(c.desc, query)
+}
+
+// paletteItems returns the commands as list items, best matches first. The
+// item ID is the index into paletteCommands.
+func (m *Model) paletteItems() []appui.ListItem {
+	query, _ := m.paletteQuery()
+	type ranked struct {
+		index, score int
+	}
+	var matches []ranked
+	commands := paletteCommands()
+	for i, c := range commands {
+		if score, ok := paletteScore(c, query); ok {
+			matches = append(matches, ranked{i, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+
+	items := make([]appui.ListItem, len(matches))
+	for i, r := range matches {
+		c := commands[r.index]
+		name := c.name
+		if c.arg != "" {
+			name += " " + c.arg
+		}
+		keys := ""
+		if b := m.keys.binding(c.action); b != nil {
+			keys = b.Help().Key
+		}
+		items[i] = appui.ListItem{ID: r.index, DisplayText: fmt.Sprintf("%-26s %-8s %s", name, keys, c.desc)}
+	}
+	return items
+}
+
+// paletteList runs the palette's items through the list overlay's
+// filtering, which also keeps the cursor in range
+func (m *Model) paletteList() appui.FilteredListResult {
+	query, _ := m.paletteQuery()
+	return appui.BuildFilteredList(appui.ListConfig{
+		Items:      m.paletteItems(),
+		Cursor:     m.paletteCursor,
+		FilterText: query,
+		Ranked:     true,
+		FilterFunc: func(item appui.ListItem, filter string) bool {
+			_, ok := paletteScore(paletteCommands()[item.ID], filter)
+			return ok
+		},
+	})
+}
+
+// updatePalette handles keys while the palette is open
+func (m Model) updatePalette(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.paletteMode = false
+		m.paletteInput.Blur()
+		return m, nil
+	case "up", "ctrl+p":
+		if m.paletteCursor > 0 {
+			m.paletteCursor--
+		}
+		return m, nil
+	case "down", "ctrl+n", "tab":
+		m.paletteCursor++
+		m.paletteCursor = m.paletteList().UpdatedCursor
+		return m, nil
+	case "enter":
+		list := m.paletteList()
+		if len(list.Items) == 0 {
+			return m, nil
+		}
+		c := paletteCommands()[list.Items[list.UpdatedCursor].ID]
+		_, arg := m.paletteQuery()
+		m.paletteMode = false
+		m.paletteInput.Blur()
+		if c.run != nil {
+			return c.run(&m, arg)
+		}
+		return m.runAction(c.action)
+	}
+
+	var cmd tea.Cmd
+	m.paletteInput, cmd = m.paletteInput.Update(msg)
+	m.paletteCursor = 0
+	return m, cmd
+}
+
+// runAction runs a key map action by pressing its first key
+func (m *Model) runAction(action string) (tea.Model, tea.Cmd) {
+	b := m.keys.binding(action)
+	if b == nil {
+		return *m, nil
+	}
+	if !b.Enabled() {
+		m.viewNotice = fmt.Sprintf("No key is bound to %s", action)
+		return *m, nil
+	}
+	return m.Update(keyMsg(b.Keys()[0]))
+}
+
+// renderPalette renders the palette as a list overlay
+func (m Model) renderPalette() string {
+	query, _ := m.paletteQuery()
+	return appui.RenderListOverlay(appui.ListConfig{
+		Title:           "Commands (↑/↓: Navigate, Enter: Run, Esc: Cancel)",
+		Items:           m.paletteItems(),
+		Cursor:          m.paletteCursor,
+		FilterText:      query,
+		BorderColor:     appui.Theme.Special,
+		Width:           m.width,
+		Height:          m.height,
+		MaxVisibleItems: 12,
+		EmptyMessage:    "No matching commands",
+		Ranked:          true,
+		NoCheckboxes:    true,
+		FilterFunc: func(item appui.ListItem, filter string) bool {
+			_, ok := paletteScore(paletteCommands()[item.ID], filter)
+			return ok
+		},
+	}, headerHeight, footerHeight)
+}
+
+// targetIDs returns the issues an argument command applies to: the marked
+// issues if any, otherwise the selected one
+func (m *Model) targetIDs() []int {
+	if len(m.markedIssues) > 0 {
+		return m.markedIDs()
+	}
+	if id := m.selectedIssueID(); id != 0 {
+		return []int{id}
+	}
+	return nil
+}
+
+// applyToTargets sends field updates for the selected or marked issues
+func (m *Model) applyToTargets(updates map[string]interface{}) tea.Cmd {
+	ids := m.targetIDs()
+	switch len(ids) {
+	case 0:
+		return nil
+	case 1:
+		if len(m.markedIssues) == 0 {
+			m.loading = true
+			return tea.Batch(
+				appui.SendLoadingMsg("Applying changes..."),
+				updateIssueFields(m.client, ids[0], updates, m.recordChange(ids[0], updates)),
+			)
+		}
+	}
+	return m.startBulkUpdate(ids, updates)
+}
+
+// paletteGoto selects an issue by ID, fetching it if it isn't loaded
+func (m *Model) paletteGoto(arg string) (tea.Model, tea.Cmd) {
+	id, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+	if err != nil || id <= 0 {
+		m.viewNotice = "Usage: :goto <issue ID>"
+		return *m, nil
+	}
+	if cmd, ok := m.selectIssue(id); ok {
+		return *m, cmd
+	}
+	for _, issue := range m.issues {
+		if issue.ID == id {
+			m.viewNotice = fmt.Sprintf("#%d is hidden by the current filters", id)
+			return *m, nil
+		}
+	}
+	client := m.client
+	return *m, tea.Batch(
+		appui.SendLoadingMsg(fmt.Sprintf("Fetching #%d...", id)),
+		func() tea.Msg {
+			issue, err := client.GetIssue(id)
+			return gotoIssueMsg{issueID: id, issue: issue, err: err}
+		},
+	)
+}
+
+// selectIssue moves the cursor to an issue if it is listed
+func (m *Model) selectIssue(id int) (tea.Cmd, bool) {
+	for i, issue := range m.getFilteredIssues() {
+		if issue.ID == id {
+			m.boardMode = false
+			m.timelineMode = false
+			m.selectedIndex = i
+			m.updatePaneContent()
+			return m.loadDetail(id), true
+		}
+	}
+	return nil, false
+}
+
+// paletteAssign assigns the selected (or marked) issues; without an
+// argument it opens the quick-actions popup
+func (m *Model) paletteAssign(arg string) (tea.Model, tea.Cmd) {
+	if arg == "" {
+		return m.runAction("actions")
+	}
+	name := strings.TrimPrefix(arg, "@")
+	var userID int
+	switch strings.ToLower(name) {
+	case "me":
+		if m.currentUser == nil {
+			m.viewNotice = "Your user isn't known yet"
+			return *m, nil
+		}
+		userID = m.currentUser.ID
+	case "nobody", "none":
+	default:
+		var matches []api.User
+		for _, u := range m.availableUsers {
+			if strings.EqualFold(u.Login, name) || strings.EqualFold(userDisplayName(u), name) {
+				matches = []api.User{u}
+				break
+			}
+			if strings.Contains(strings.ToLower(userDisplayName(u)), strings.ToLower(name)) {
+				matches = append(matches, u)
+			}
+		}
+		switch {
+		case len(m.availableUsers) == 0:
+			m.viewNotice = "Users are still loading, try again"
+			return *m, nil
+		case len(matches) == 0:
+			m.viewNotice = fmt.Sprintf("No user matches %q", name)
+			return *m, nil
+		case len(matches) > 1:
+			m.viewNotice = fmt.Sprintf("%q matches %d users", name, len(matches))
+			return *m, nil
+		}
+		userID = matches[0].ID
+	}
+	updates := map[string]interface{}{"assigned_to_id": userID}
+	if userID == 0 {
+		updates["assigned_to_id"] = nil
+	}
+	return *m, m.applyToTargets(updates)
+}
+
+// paletteStatus sets the status of the selected (or marked) issues by name
+// (or a unique prefix); without an argument it opens the status picker
+func (m *Model) paletteStatus(arg string) (tea.Model, tea.Cmd) {
+	if arg == "" {
+		return m.runAction("status")
+	}
+	var matches []api.Status
+	for _, st := range m.availableStatuses {
+		if strings.EqualFold(st.Name, arg) {
+			matches = []api.Status{st}
+			break
+		}
+		if strings.HasPrefix(strings.ToLower(st.Name), strings.ToLower(arg)) {
+			matches = append(matches, st)
+		}
+	}
+	switch {
+	case len(m.availableStatuses) == 0:
+		m.viewNotice = "Statuses are still loading, try again"
+		return *m, nil
+	case len(matches) != 1:
+		m.viewNotice = fmt.Sprintf("%q matches %d statuses", arg, len(matches))
+		return *m, nil
+	}
+	return *m, m.applyToTargets(map[string]interface{}{"status_id": matches[0].ID})
+}
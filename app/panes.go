@@ -2,87 +2,15 @@ package app
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 
-	"github.com/ktsopanakis/redmine-tui/config"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
 )
 
-// getUserNameByID returns the user name for a given user ID
-func (m *Model) getUserNameByID(userID string) string {
-	if userID == "" {
-		return ""
-	}
-
-	id, err := strconv.Atoi(userID)
-	if err != nil {
-		return userID // Return the ID string if conversion fails
-	}
-
-	for _, user := range m.availableUsers {
-		if user.ID == id {
-			// Build name from firstname + lastname if Name is empty
-			if user.Name != "" {
-				return user.Name
-			}
-			name := strings.TrimSpace(user.Firstname + " " + user.Lastname)
-			if name != "" {
-				return name
-			}
-			if user.Login != "" {
-				return user.Login
-			}
-		}
-	}
-
-	// If user not found, try to return a more friendly display
-	return "User #" + userID
-}
-
-// getStatusNameByID returns the status name for a given status ID
-func (m *Model) getStatusNameByID(statusID string) string {
-	if statusID == "" {
-		return ""
-	}
-
-	id, err := strconv.Atoi(statusID)
-	if err != nil {
-		return statusID
-	}
-
-	for _, status := range m.availableStatuses {
-		if status.ID == id {
-			return status.Name
-		}
-	}
-
-	return "Status #" + statusID
-}
-
-// getTrackerNameByID returns the tracker name for a given tracker ID by searching through issues
-func (m *Model) getTrackerNameByID(trackerID string) string {
-	if trackerID == "" {
-		return ""
-	}
-
-	id, err := strconv.Atoi(trackerID)
-	if err != nil {
-		return trackerID
-	}
-
-	// Search through all issues to find a matching tracker
-	for _, issue := range m.issues {
-		if issue.Tracker.ID == id {
-			return issue.Tracker.Name
-		}
-	}
-
-	return "Tracker #" + trackerID
-}
-
 func (m *Model) updatePaneContent() {
+	m.journalLine = -1
 	if !m.ready {
 		return
 	}
@@ -131,10 +59,10 @@ func (m *Model) updatePaneContent() {
 
 		// Show active filters at the top if present
 		filterStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#61AFEF")).
+			Foreground(lipgloss.Color(appui.Theme.Accent)).
 			Bold(true)
 		filterValueStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#E5C07B"))
+			Foreground(lipgloss.Color(appui.Theme.Warning))
 
 		filterLinesAdded := 0
 
@@ -182,7 +110,7 @@ func (m *Model) updatePaneContent() {
 
 		// Add separator if any filters are active
 		if filterLinesAdded > 0 {
-			leftContent += lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render(strings.Repeat("─", m.leftPane.Width)) + "\n\n"
+			leftContent += lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted)).Render(strings.Repeat("─", m.leftPane.Width)) + "\n\n"
 			filterLinesAdded += 2 // separator and blank line
 			// Reduce visible lines to account for filter display
 			visibleLines -= filterLinesAdded
@@ -211,37 +139,52 @@ func (m *Model) updatePaneContent() {
 			isSelected := i == m.selectedIndex
 
 			// Styles for different components with vibrant colors
-			idStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00D7FF"))       // Cyan
-			titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF"))    // White
-			statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700"))   // Gold
-			projectStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#98C379"))  // Green
-			assigneeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#C678DD")) // Purple
+			idStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.IssueID))
+			titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Title))
+			statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor))
+			projectStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))
+			assigneeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Special))
 
 			var linePrefix string
 			spacerStyle := lipgloss.NewStyle() // For spaces/dots between elements
+			selected := appui.SelectedStyle()
 			if isSelected {
-				// Subtle background tint + bold for selection
-				subtleBg := lipgloss.Color("#2A2A3A") // Dark subtle background
-				idStyle = idStyle.Background(subtleBg).Bold(true)
-				titleStyle = titleStyle.Background(subtleBg).Bold(true)
-				statusStyle = statusStyle.Background(subtleBg).Bold(true)
-				projectStyle = projectStyle.Background(subtleBg).Bold(true)
-				assigneeStyle = assigneeStyle.Background(subtleBg).Bold(true)
-				spacerStyle = spacerStyle.Background(subtleBg) // Apply background to spacers too
+				// Subtle background tint (or reverse video) + bold for selection
+				idStyle = idStyle.Inherit(selected).Bold(true)
+				titleStyle = titleStyle.Inherit(selected).Bold(true)
+				statusStyle = statusStyle.Inherit(selected).Bold(true)
+				projectStyle = projectStyle.Inherit(selected).Bold(true)
+				assigneeStyle = assigneeStyle.Inherit(selected).Bold(true)
+				spacerStyle = selected // Apply the selection to spacers too
 				linePrefix = lipgloss.NewStyle().
-					Foreground(lipgloss.Color(config.Current.Colors.ActivePaneBorder)).
-					Background(subtleBg).
+					Foreground(lipgloss.Color(appui.Theme.ActivePaneBorder)).
+					Inherit(selected).
 					Render("▌")
 			} else {
 				linePrefix = " "
 			}
 
-			// Line 1: ID and Subject
-			line1 := linePrefix + idStyle.Render(fmt.Sprintf("#%d", issue.ID)) + spacerStyle.Render(" ") + titleStyle.Render(issue.Subject)
+			// Line 1: ID and Subject, with a marker for unseen background updates
+			updatedMarker := ""
+			if m.unseenUpdates[issue.ID] {
+				markerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning)).Bold(true)
+				if isSelected {
+					markerStyle = markerStyle.Inherit(selected)
+				}
+				updatedMarker = markerStyle.Render("● ")
+			}
+			if m.markedIssues[issue.ID] {
+				markStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Special)).Bold(true)
+				if isSelected {
+					markStyle = markStyle.Inherit(selected)
+				}
+				updatedMarker = markStyle.Render("✓ ") + updatedMarker
+			}
+			line1 := linePrefix + updatedMarker + idStyle.Render(fmt.Sprintf("#%d", issue.ID)) + spacerStyle.Render(" ") + titleStyle.Render(issue.Subject)
 			if isSelected {
 				// Pad to full width for complete background
 				availableWidth := m.leftPane.Width
-				currentLen := len(fmt.Sprintf("#%d %s", issue.ID, issue.Subject)) + 1
+				currentLen := len(fmt.Sprintf("#%d %s", issue.ID, issue.Subject)) + 1 + lipgloss.Width(updatedMarker)
 				if currentLen < availableWidth {
 					line1 += spacerStyle.Render(strings.Repeat(" ", availableWidth-currentLen))
 				}
@@ -330,6 +273,9 @@ func (m *Model) updatePaneContent() {
 	} else {
 		m.leftTitle = viewModeText
 	}
+	if len(m.markedIssues) > 0 {
+		m.leftTitle += fmt.Sprintf(" · %d marked", len(m.markedIssues))
+	}
 
 	// Right pane: Selected issue details
 	var rightContent string
@@ -349,12 +295,12 @@ func (m *Model) updatePaneContent() {
 		m.rightTitle = fmt.Sprintf("#%d", issue.ID)
 
 		// Color styles matching the left pane
-		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700")).Bold(true)   // Gold
-		projectStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#98C379")).Bold(true)  // Green
-		assigneeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#C678DD")).Bold(true) // Purple
-		labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#61AFEF"))               // Blue
-		titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Bold(true)    // White, bold
-		sectionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#E5C07B")).Bold(true)  // Yellow
+		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+		projectStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success)).Bold(true)
+		assigneeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Special)).Bold(true)
+		labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent))
+		titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Title)).Bold(true)
+		sectionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning)).Bold(true)
 
 		// Highlight style for edit mode
 		highlightStyle := getFieldHighlightStyle()
@@ -388,12 +334,25 @@ func (m *Model) updatePaneContent() {
 			return originalValue
 		}
 
+		// Validation errors from the last failed save, shown next to the
+		// field they refer to
+		errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error)).Bold(true)
+		fieldError := func(fieldName string) string {
+			if !m.editMode {
+				return ""
+			}
+			if msg, ok := m.saveErrors[fieldName]; ok {
+				return " " + errorStyle.Render("✗ "+msg)
+			}
+			return ""
+		}
+
 		// Display pending edits summary at the top if any exist
 		if m.editMode && len(m.pendingEdits) > 0 {
-			pendingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#E5C07B")).Bold(true) // Yellow
-			oldValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#E06C75"))           // Red
-			newValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#98C379"))           // Green
-			arrowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#666666"))              // Gray
+			pendingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning)).Bold(true)
+			oldValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error))
+			newValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))
+			arrowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted)) // Gray
 
 			rightContent = pendingStyle.Render("PENDING CHANGES:") + "\n"
 			for _, field := range editableFields {
@@ -411,30 +370,69 @@ func (m *Model) updatePaneContent() {
 		// Subject (title) - field 0
 		subjectValue := getDisplayValue("subject", issue.Subject)
 		if currentField == "subject" {
-			rightContent = labelStyle.Render("Subject: ") + highlightStyle.Render(subjectValue) + "\n\n"
+			rightContent = labelStyle.Render("Subject: ") + highlightStyle.Render(subjectValue) + fieldError("subject") + "\n\n"
 		} else {
-			rightContent = labelStyle.Render("Subject: ") + titleStyle.Render(subjectValue) + "\n\n"
+			rightContent = labelStyle.Render("Subject: ") + titleStyle.Render(subjectValue) + fieldError("subject") + "\n\n"
+		}
+
+		// Save errors that couldn't be attributed to a specific field
+		if msg := fieldError(""); msg != "" {
+			rightContent += errorStyle.Render("Save failed:") + msg + "\n\n"
 		}
 
 		// Static information (color-coded)
 		// Status - field 2
 		statusValue := getDisplayValue("status_id", issue.Status.Name)
 		if currentField == "status_id" {
-			rightContent += labelStyle.Render("Status: ") + highlightStyle.Render(statusValue) + "  "
+			rightContent += labelStyle.Render("Status: ") + highlightStyle.Render(statusValue) + fieldError("status_id") + "  "
 		} else {
-			rightContent += labelStyle.Render("Status: ") + statusStyle.Render(statusValue) + "  "
+			rightContent += labelStyle.Render("Status: ") + statusStyle.Render(statusValue) + fieldError("status_id") + "  "
 		}
 
 		// Priority - field 3
 		priorityValue := getDisplayValue("priority_id", issue.Priority.Name)
 		if currentField == "priority_id" {
-			rightContent += labelStyle.Render("Priority: ") + highlightStyle.Render(priorityValue) + "\n"
+			rightContent += labelStyle.Render("Priority: ") + highlightStyle.Render(priorityValue) + fieldError("priority_id") + "\n"
 		} else {
-			rightContent += labelStyle.Render("Priority: ") + statusStyle.Render(priorityValue) + "\n"
+			rightContent += labelStyle.Render("Priority: ") + statusStyle.Render(priorityValue) + fieldError("priority_id") + "\n"
 		}
 
-		rightContent += labelStyle.Render("Project: ") + projectStyle.Render(issue.Project.Name) + "  "
-		rightContent += labelStyle.Render("Tracker: ") + projectStyle.Render(issue.Tracker.Name) + "\n"
+		projectValue := getDisplayValue("project_id", issue.Project.Name)
+		if currentField == "project_id" {
+			rightContent += labelStyle.Render("Project: ") + highlightStyle.Render(projectValue) + fieldError("project_id") + "  "
+		} else {
+			rightContent += labelStyle.Render("Project: ") + projectStyle.Render(projectValue) + fieldError("project_id") + "  "
+		}
+		trackerValue := getDisplayValue("tracker_id", issue.Tracker.Name)
+		if currentField == "tracker_id" {
+			rightContent += labelStyle.Render("Tracker: ") + highlightStyle.Render(trackerValue) + fieldError("tracker_id") + "  "
+		} else {
+			rightContent += labelStyle.Render("Tracker: ") + projectStyle.Render(trackerValue) + fieldError("tracker_id") + "  "
+		}
+
+		// Category, shown when set or being edited
+		category := noCategory
+		if issue.Category != nil {
+			category = issue.Category.Name
+		}
+		categoryValue := getDisplayValue("category_id", category)
+		if currentField == "category_id" {
+			rightContent += labelStyle.Render("Category: ") + highlightStyle.Render(categoryValue) + fieldError("category_id") + "  "
+		} else if categoryValue != noCategory || fieldError("category_id") != "" {
+			rightContent += labelStyle.Render("Category: ") + projectStyle.Render(categoryValue) + fieldError("category_id") + "  "
+		}
+
+		// Target version
+		version := noVersion
+		if issue.FixedVersion != nil {
+			version = issue.FixedVersion.Name
+		}
+		versionValue := getDisplayValue("fixed_version_id", version)
+		if currentField == "fixed_version_id" {
+			rightContent += labelStyle.Render("Version: ") + highlightStyle.Render(versionValue) + fieldError("fixed_version_id") + "\n"
+		} else {
+			rightContent += labelStyle.Render("Version: ") + projectStyle.Render(versionValue) + fieldError("fixed_version_id") + "\n"
+		}
 
 		assignee := "Unassigned"
 		if issue.AssignedTo != nil {
@@ -443,9 +441,9 @@ func (m *Model) updatePaneContent() {
 		// Assigned - field 4
 		assigneeValue := getDisplayValue("assigned_to_id", assignee)
 		if currentField == "assigned_to_id" {
-			rightContent += labelStyle.Render("Assigned: ") + highlightStyle.Render(assigneeValue) + "  "
+			rightContent += labelStyle.Render("Assigned: ") + highlightStyle.Render(assigneeValue) + fieldError("assigned_to_id") + "  "
 		} else {
-			rightContent += labelStyle.Render("Assigned: ") + assigneeStyle.Render(assigneeValue) + "  "
+			rightContent += labelStyle.Render("Assigned: ") + assigneeStyle.Render(assigneeValue) + fieldError("assigned_to_id") + "  "
 		}
 		rightContent += labelStyle.Render("Author: ") + assigneeStyle.Render(issue.Author.Name) + "\n"
 
@@ -453,30 +451,73 @@ func (m *Model) updatePaneContent() {
 		progressText := fmt.Sprintf("%d%%", issue.DoneRatio)
 		progressValue := getDisplayValue("done_ratio", progressText)
 		if currentField == "done_ratio" {
-			rightContent += labelStyle.Render("Progress: ") + highlightStyle.Render(progressValue)
+			rightContent += labelStyle.Render("Progress: ") + highlightStyle.Render(progressValue) + fieldError("done_ratio")
 		} else {
-			rightContent += labelStyle.Render("Progress: ") + statusStyle.Render(progressValue)
+			rightContent += labelStyle.Render("Progress: ") + statusStyle.Render(progressValue) + fieldError("done_ratio")
 		}
-		if issue.StartDate != "" {
-			rightContent += "  " + labelStyle.Render("Start: ") + issue.StartDate
+		// Start Date
+		startValue := getDisplayValue("start_date", issue.StartDate)
+		if issue.StartDate != "" || currentField == "start_date" || fieldError("start_date") != "" {
+			if currentField == "start_date" {
+				rightContent += "  " + labelStyle.Render("Start: ") + highlightStyle.Render(startValue) + fieldError("start_date")
+			} else {
+				rightContent += "  " + labelStyle.Render("Start: ") + startValue + fieldError("start_date")
+			}
 		}
 		// Due Date - field 6
 		dueValue := getDisplayValue("due_date", issue.DueDate)
-		if issue.DueDate != "" || currentField == "due_date" {
+		if issue.DueDate != "" || currentField == "due_date" || fieldError("due_date") != "" {
 			if currentField == "due_date" {
-				rightContent += "  " + labelStyle.Render("Due: ") + highlightStyle.Render(dueValue)
+				rightContent += "  " + labelStyle.Render("Due: ") + highlightStyle.Render(dueValue) + fieldError("due_date")
 			} else {
-				rightContent += "  " + labelStyle.Render("Due: ") + dueValue
+				rightContent += "  " + labelStyle.Render("Due: ") + dueValue + fieldError("due_date")
 			}
 		}
 		rightContent += "\n"
 
+		// Estimated vs. spent hours, including subtasks
+		estimated := ""
+		if issue.EstimatedHours != nil {
+			estimated = formatHours(*issue.EstimatedHours)
+		}
+		estimatedValue := getDisplayValue("estimated_hours", estimated)
+		if estimatedValue != "" || issue.SpentHours > 0 || issue.TotalSpentHours > 0 ||
+			currentField == "estimated_hours" || fieldError("estimated_hours") != "" {
+			shown := "none"
+			if estimatedValue != "" {
+				shown = estimatedValue + "h"
+			}
+			if currentField == "estimated_hours" {
+				rightContent += labelStyle.Render("Estimated: ") + highlightStyle.Render(shown) + fieldError("estimated_hours")
+			} else {
+				rightContent += labelStyle.Render("Estimated: ") + shown + fieldError("estimated_hours")
+			}
+			if issue.TotalEstimatedHours != nil && (issue.EstimatedHours == nil || *issue.TotalEstimatedHours != *issue.EstimatedHours) {
+				rightContent += " (" + formatHours(*issue.TotalEstimatedHours) + "h with subtasks)"
+			}
+
+			spentText := formatHours(issue.SpentHours) + "h"
+			if issue.TotalSpentHours != 0 && issue.TotalSpentHours != issue.SpentHours {
+				spentText += " (" + formatHours(issue.TotalSpentHours) + "h with subtasks)"
+			}
+			if over := overBudget(issue); over > 0 {
+				// Over budget: spent more than estimated
+				rightContent += "  " + labelStyle.Render("Spent: ") + errorStyle.Render(spentText+" · over by "+formatHours(over)+"h")
+			} else {
+				rightContent += "  " + labelStyle.Render("Spent: ") + spentText
+			}
+			rightContent += "\n"
+		}
+
 		rightContent += labelStyle.Render("Created: ") + issue.CreatedOn.Format("2006-01-02 15:04") + "  "
 		rightContent += labelStyle.Render("Updated: ") + issue.UpdatedOn.Format("2006-01-02 15:04") + "\n\n"
 
 		// Description section - field 1
 		rightContent += sectionStyle.Render("━━━ DESCRIPTION ") + sectionStyle.Render(strings.Repeat("━", m.rightPane.Width-17)) + "\n\n"
 		descValue := getDisplayValue("description", issue.Description)
+		if msg := fieldError("description"); msg != "" {
+			rightContent += msg + "\n"
+		}
 		if descValue != "" {
 			if currentField == "description" {
 				rightContent += highlightStyle.Render(descValue) + "\n"
@@ -487,7 +528,7 @@ func (m *Model) updatePaneContent() {
 			if currentField == "description" {
 				rightContent += highlightStyle.Render("No description provided.") + "\n"
 			} else {
-				rightContent += lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render("No description provided.") + "\n"
+				rightContent += lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted)).Render("No description provided.") + "\n"
 			}
 		}
 
@@ -496,52 +537,39 @@ func (m *Model) updatePaneContent() {
 
 		if len(issue.Journals) > 0 {
 			for _, journal := range issue.Journals {
-				userStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#C678DD")).Bold(true)
-				dateStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#666666"))
-
-				rightContent += userStyle.Render(journal.User.Name) + " " + dateStyle.Render(journal.CreatedOn.Format("2006-01-02 15:04")) + "\n"
+				userStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Special)).Bold(true)
+				dateStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+
+				if journal.ID == m.journalCursor {
+					// Selected for a reply ([ / ] to move, R to reply)
+					m.journalLine = lipgloss.Height(lipgloss.NewStyle().Width(m.rightPane.Width).Render(rightContent)) - 1
+					rightContent += lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true).Render("▶ " + journal.User.Name)
+				} else {
+					rightContent += userStyle.Render(journal.User.Name)
+				}
+				rightContent += " " + dateStyle.Render(journal.CreatedOn.Format("2006-01-02 15:04"))
+				if journal.PrivateNotes {
+					rightContent += " " + lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error)).Bold(true).Render("[private]")
+				}
+				rightContent += "\n"
 
 				// Show property changes
 				if len(journal.Details) > 0 {
 					for _, detail := range journal.Details {
-						fieldStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#61AFEF")).Bold(true) // Cyan for field name
-						oldValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#E5C07B"))         // Yellow/orange for old value
-						newValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#98C379"))         // Green for new value
-						arrowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#666666"))            // Gray arrow
-
-						// Get display values (convert IDs to names)
-						oldValue := detail.OldValue
-						newValue := detail.NewValue
-						fieldName := detail.Name
-
-						if detail.Name == "assigned_to_id" || detail.Name == "assigned_to" {
-							fieldName = "Assigned to"
-							if oldValue != "" {
-								oldValue = m.getUserNameByID(oldValue)
-							} else {
-								oldValue = "(unassigned)"
-							}
-							if newValue != "" {
-								newValue = m.getUserNameByID(newValue)
-							} else {
-								newValue = "(unassigned)"
-							}
-						} else if detail.Name == "status_id" || detail.Name == "status" {
-							fieldName = "Status"
-							if oldValue != "" {
-								oldValue = m.getStatusNameByID(oldValue)
-							}
-							if newValue != "" {
-								newValue = m.getStatusNameByID(newValue)
-							}
-						} else if detail.Name == "tracker_id" || detail.Name == "tracker" {
-							fieldName = "Tracker"
-							if oldValue != "" {
-								oldValue = m.getTrackerNameByID(oldValue)
-							}
-							if newValue != "" {
-								newValue = m.getTrackerNameByID(newValue)
-							}
+						fieldStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent)).Bold(true) // field name
+						oldValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning))        // old value
+						newValueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))        // new value
+						arrowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))             // arrow
+
+						// Resolve IDs and custom fields to human labels
+						fieldName, oldValue, newValue := m.journalDetailText(issue, detail)
+
+						// Long texts would drown the pane; the history view has the diff
+						if detail.Property == "attr" && detail.Name == "description" {
+							added, removed := m.descriptionDiffStat(journal.ID, detail)
+							rightContent += "  " + fieldStyle.Render(fieldName+":") + " " +
+								arrowStyle.Render(fmt.Sprintf("changed (+%d −%d lines, H: diff)", added, removed)) + "\n"
+							continue
 						}
 
 						if detail.OldValue != "" && detail.NewValue != "" {
@@ -569,7 +597,7 @@ func (m *Model) updatePaneContent() {
 				rightContent += "\n"
 			}
 		} else {
-			rightContent += lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render("No history available.") + "\n"
+			rightContent += lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted)).Render("No history available.") + "\n"
 		}
 	}
 	m.rightPane.SetContent(lipgloss.NewStyle().Width(m.rightPane.Width).Render(rightContent))
@@ -0,0 +1,177 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	"github.com/ktsopanakis/redmine-tui/config"
+)
+
+// syncOverlap is subtracted from the last sync time when polling, so clock
+// skew between us and the server can't hide an update. Unchanged issues in
+// the overlap are recognised by their updated_on and ignored.
+const syncOverlap = time.Minute
+
+// refreshTickMsg triggers a background poll for changed issues
+type refreshTickMsg struct{}
+
+// issueChangesMsg carries issues updated since the last sync, and the
+// listed issues that were updated but no longer belong in the view
+type issueChangesMsg struct {
+	issues    []api.Issue
+	left      []int
+	fetchedAt time.Time
+	err       error
+}
+
+// refreshTickCmd schedules the next background poll, if polling is enabled
+func refreshTickCmd() tea.Cmd {
+	interval := config.Current.Refresh.Interval
+	if interval <= 0 {
+		return nil
+	}
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return refreshTickMsg{}
+	})
+}
+
+// fetchIssueChanges asks for issues in the current view updated since the
+// last sync. Closed issues are included so they can be dropped from the
+// open-issues list. The listed issues are polled too: one that was updated
+// but isn't in the view's results any more (e.g. reassigned to someone
+// else or moved to another project) has left the view.
+func fetchIssueChanges(client *api.Client, viewMode string, assigneeFilter string, projectFilter string, issues []api.Issue, since time.Time) tea.Cmd {
+	q := issueQueryFor(viewMode, assigneeFilter, projectFilter, issues)
+	q.StatusID = "*"
+	q.UpdatedSince = since
+	listed := api.IssueQuery{StatusID: "*", UpdatedSince: since, Limit: 100}
+	for _, issue := range issues {
+		listed.IssueIDs = append(listed.IssueIDs, issue.ID)
+	}
+	return func() tea.Msg {
+		ctx := context.Background()
+		fetchedAt := time.Now()
+		resp, err := client.GetIssuesQuery(ctx, q)
+		if err != nil {
+			return issueChangesMsg{err: err}
+		}
+		msg := issueChangesMsg{issues: resp.Issues, fetchedAt: fetchedAt}
+		// A truncated page can't tell us which issues are missing from it
+		if len(listed.IssueIDs) == 0 || resp.TotalCount > len(resp.Issues) {
+			return msg
+		}
+		updated, err := client.GetIssuesQuery(ctx, listed)
+		if err != nil {
+			return issueChangesMsg{err: err}
+		}
+		inView := make(map[int]bool)
+		for _, issue := range resp.Issues {
+			inView[issue.ID] = true
+		}
+		for _, issue := range updated.Issues {
+			if !inView[issue.ID] {
+				msg.left = append(msg.left, issue.ID)
+			}
+		}
+		return msg
+	}
+}
+
+// isClosedStatus reports whether a status ID is one of the closed statuses
+func (m *Model) isClosedStatus(statusID int) bool {
+	for _, s := range m.availableStatuses {
+		if s.ID == statusID {
+			return s.IsClosed
+		}
+	}
+	return false
+}
+
+// mergeIssueChanges folds background-refreshed issues into the list, and
+// drops those that left the view, without moving the cursor off the
+// selected issue. It returns the IDs of issues that actually changed.
+func (m *Model) mergeIssueChanges(changed []api.Issue, left []int) []int {
+	selectedID := m.selectedIssueID()
+	m.seedLookups(changed...)
+
+	for _, id := range left {
+		for i, issue := range m.issues {
+			if issue.ID == id {
+				m.issues = append(m.issues[:i], m.issues[i+1:]...)
+				delete(m.unseenUpdates, id)
+				delete(m.timelinePending, id)
+				break
+			}
+		}
+	}
+
+	var changedIDs []int
+	for _, upd := range changed {
+		idx := -1
+		for i, issue := range m.issues {
+			if issue.ID == upd.ID {
+				idx = i
+				break
+			}
+		}
+		closed := m.isClosedStatus(upd.Status.ID)
+		switch {
+		case idx >= 0 && closed:
+			m.issues = append(m.issues[:idx], m.issues[idx+1:]...)
+			delete(m.unseenUpdates, upd.ID)
+			delete(m.timelinePending, upd.ID)
+		case idx >= 0:
+			if m.issues[idx].UpdatedOn.Equal(upd.UpdatedOn) {
+				continue
+			}
+			if _, ok := m.timelinePending[upd.ID]; ok {
+				// Keep the unsaved timeline shift; the polled issue becomes
+				// what a discard restores
+				m.timelinePending[upd.ID] = upd
+				upd.StartDate, upd.DueDate = m.issues[idx].StartDate, m.issues[idx].DueDate
+			}
+			m.issues[idx] = upd
+			m.unseenUpdates[upd.ID] = true
+			changedIDs = append(changedIDs, upd.ID)
+		case !closed:
+			m.issues = append([]api.Issue{upd}, m.issues...)
+			m.unseenUpdates[upd.ID] = true
+			changedIDs = append(changedIDs, upd.ID)
+		}
+	}
+
+	// Keep the cursor on the same issue; fall back to clamping if it went away
+	filteredIssues := m.getFilteredIssues()
+	for i, issue := range filteredIssues {
+		if issue.ID == selectedID {
+			m.selectedIndex = i
+			break
+		}
+	}
+	if m.selectedIndex >= len(filteredIssues) {
+		m.selectedIndex = len(filteredIssues) - 1
+	}
+	if m.selectedIndex < 0 {
+		m.selectedIndex = 0
+	}
+	return changedIDs
+}
+
+// markSeen clears the "updated" highlight once the user views an issue
+func (m *Model) markSeen(issueID int) {
+	delete(m.unseenUpdates, issueID)
+}
+
+// unseenCount returns how many listed issues have unseen background updates
+func (m *Model) unseenCount() int {
+	n := 0
+	for _, issue := range m.issues {
+		if m.unseenUpdates[issue.ID] {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,119 @@
+package app
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	"github.com/ktsopanakis/redmine-tui/config"
+)
+
+// quoteNotes quotes a note in the server's text formatting: Textile quotes
+// each paragraph with "bq.", Markdown prefixes every line with ">".
+func quoteNotes(notes, formatting string) string {
+	notes = strings.TrimSpace(strings.ReplaceAll(notes, "\r\n", "\n"))
+	switch formatting {
+	case "markdown", "common_mark":
+		lines := strings.Split(notes, "\n")
+		for i, l := range lines {
+			if l == "" {
+				lines[i] = ">"
+			} else {
+				lines[i] = "> " + l
+			}
+		}
+		return strings.Join(lines, "\n")
+	default:
+		var paragraphs []string
+		for _, p := range strings.Split(notes, "\n\n") {
+			if p = strings.TrimSpace(p); p != "" {
+				paragraphs = append(paragraphs, "bq. "+p)
+			}
+		}
+		return strings.Join(paragraphs, "\n\n")
+	}
+}
+
+// replyText builds the pre-filled reply to a journal note: an "@login
+// wrote:" header (mentioning the author) and the quoted note
+func (m *Model) replyText(j api.Journal) string {
+	who := j.User.Name
+	for _, u := range m.availableUsers {
+		if u.ID == j.User.ID && u.Login != "" {
+			who = u.Login
+			break
+		}
+	}
+	return "@" + who + " wrote:\n\n" + quoteNotes(j.Notes, config.Current.Redmine.TextFormatting) + "\n\n"
+}
+
+// replyTo opens the note editor with a quoted reply to a journal note.
+// Replies to private notes start out private.
+func (m *Model) replyTo(issueID int, j api.Journal) tea.Cmd {
+	m.showModal = false
+	m.modalType = ""
+	m.noteMode = true
+	m.noteIssueID = issueID
+	m.noteJournal = 0
+	m.noteUpdates = nil
+	m.notePrivate = j.PrivateNotes
+	m.noteInput.Reset()
+	m.noteInput.SetValue(m.replyText(j))
+	return m.noteInput.Focus()
+}
+
+// noteJournals returns the selected issue's journals that have notes, the
+// ones the details pane can select for a reply
+func (m *Model) noteJournals() []api.Journal {
+	issue := m.editingIssue()
+	if issue == nil {
+		return nil
+	}
+	var out []api.Journal
+	for _, j := range issue.Journals {
+		if j.Notes != "" {
+			out = append(out, j)
+		}
+	}
+	return out
+}
+
+// stepJournal moves the details pane's note selection by delta, starting
+// from the newest note when nothing on this issue is selected
+func (m *Model) stepJournal(delta int) {
+	notes := m.noteJournals()
+	if len(notes) == 0 {
+		m.journalCursor = 0
+		return
+	}
+	idx := -1
+	for i, j := range notes {
+		if j.ID == m.journalCursor {
+			idx = i
+			break
+		}
+	}
+	switch {
+	case idx < 0:
+		idx = len(notes) - 1
+	case idx+delta >= 0 && idx+delta < len(notes):
+		idx += delta
+	}
+	m.journalCursor = notes[idx].ID
+}
+
+// selectedJournal returns the note selected in the details pane, or the
+// newest note if none is
+func (m *Model) selectedJournal() (api.Journal, bool) {
+	notes := m.noteJournals()
+	if len(notes) == 0 {
+		return api.Journal{}, false
+	}
+	for _, j := range notes {
+		if j.ID == m.journalCursor {
+			return j, true
+		}
+	}
+	return notes[len(notes)-1], true
+}
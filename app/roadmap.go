@@ -0,0 +1,341 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// roadmapPageSize is how many issues are fetched per request; a version's
+// issues are paged through so the counts cover all of them
+const roadmapPageSize = 100
+
+// versionIssuesMsg carries the issues targeted at one version
+type versionIssuesMsg struct {
+	versionID int
+	issues    []api.Issue
+	err       error
+}
+
+func fetchVersionIssues(client *api.Client, versionID int) tea.Cmd {
+	return func() tea.Msg {
+		var issues []api.Issue
+		for {
+			resp, err := client.GetIssuesQuery(context.Background(), api.IssueQuery{
+				FixedVersionID: versionID, StatusID: "*", Limit: roadmapPageSize, Offset: len(issues),
+			})
+			if err != nil {
+				return versionIssuesMsg{versionID: versionID, err: err}
+			}
+			issues = append(issues, resp.Issues...)
+			if len(resp.Issues) == 0 || len(issues) >= resp.TotalCount {
+				break
+			}
+		}
+		return versionIssuesMsg{versionID: versionID, issues: issues}
+	}
+}
+
+// roadmapProjectIDs returns the projects the roadmap covers: the project
+// filter if set, otherwise the projects of the listed issues
+func (m *Model) roadmapProjectIDs() []int {
+	var ids []int
+	seen := make(map[int]bool)
+	if m.projectFilter != "" {
+		for _, s := range strings.Split(m.projectFilter, ",") {
+			if id, err := strconv.Atoi(s); err == nil && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+	for _, issue := range m.issues {
+		if !seen[issue.Project.ID] {
+			seen[issue.Project.ID] = true
+			ids = append(ids, issue.Project.ID)
+		}
+	}
+	return ids
+}
+
+// roadmapVersions returns the versions of the covered projects ordered by
+// due date (undated last). Closed versions are hidden unless toggled on.
+func (m *Model) roadmapVersions() []api.Version {
+	seen := make(map[int]bool)
+	var out []api.Version
+	for _, projectID := range m.roadmapProjectIDs() {
+		for _, v := range m.projectVersions[projectID] {
+			if seen[v.ID] || (v.Status == "closed" && !m.roadmapShowClosed) {
+				continue
+			}
+			seen[v.ID] = true
+			out = append(out, v)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if (out[i].DueDate == "") != (out[j].DueDate == "") {
+			return out[j].DueDate == ""
+		}
+		if out[i].DueDate != out[j].DueDate {
+			return out[i].DueDate < out[j].DueDate
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// versionProgress counts a version's open and closed issues and its percent
+// done, counting closed issues as complete like Redmine's roadmap does
+func (m *Model) versionProgress(versionID int) (open, closed, percent int) {
+	issues := m.roadmapIssues[versionID]
+	if len(issues) == 0 {
+		return 0, 0, 0
+	}
+	sum := 0
+	for _, issue := range issues {
+		if m.isClosedStatus(issue.Status.ID) {
+			closed++
+			sum += 100
+		} else {
+			open++
+			sum += issue.DoneRatio
+		}
+	}
+	return open, closed, sum / len(issues)
+}
+
+// openRoadmap shows the roadmap and fetches whatever it is missing
+func (m *Model) openRoadmap() tea.Cmd {
+	m.showModal = true
+	m.modalType = "roadmap"
+	m.modalScroll = 0
+	m.roadmapCursor = 0
+	m.roadmapDrill = 0
+
+	var cmds []tea.Cmd
+	if len(m.availableStatuses) == 0 {
+		cmds = append(cmds, appui.SendLoadingMsg("Fetching statuses..."), fetchStatuses(m.client))
+	}
+	for _, projectID := range m.roadmapProjectIDs() {
+		if _, ok := m.projectVersions[projectID]; !ok {
+			cmds = append(cmds, appui.SendLoadingMsg("Fetching versions..."), fetchVersions(m.client, projectID))
+		}
+	}
+	cmds = append(cmds, m.fetchRoadmapIssues())
+	return tea.Batch(cmds...)
+}
+
+// fetchRoadmapIssues requests the issues of roadmap versions not loaded yet
+func (m *Model) fetchRoadmapIssues() tea.Cmd {
+	var cmds []tea.Cmd
+	for _, v := range m.roadmapVersions() {
+		if _, ok := m.roadmapIssues[v.ID]; ok || m.roadmapFetching[v.ID] {
+			continue
+		}
+		m.roadmapFetching[v.ID] = true
+		cmds = append(cmds, appui.SendLoadingMsg("Fetching version issues..."), fetchVersionIssues(m.client, v.ID))
+	}
+	return tea.Batch(cmds...)
+}
+
+// updateRoadmap handles keys while the roadmap is open. Enter drills into a
+// version's issues; Enter on an issue jumps to it in the list.
+func (m Model) updateRoadmap(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.roadmapDrill != 0 {
+		issues := m.roadmapIssues[m.roadmapDrill]
+		switch msg.String() {
+		case "esc", "backspace":
+			m.roadmapDrill = 0
+		case "up", "k":
+			if m.roadmapIssueCursor > 0 {
+				m.roadmapIssueCursor--
+			}
+		case "down", "j":
+			if m.roadmapIssueCursor < len(issues)-1 {
+				m.roadmapIssueCursor++
+			}
+		case "enter":
+			if m.roadmapIssueCursor >= len(issues) {
+				return m, nil
+			}
+			issueID := issues[m.roadmapIssueCursor].ID
+			for i, issue := range m.getFilteredIssues() {
+				if issue.ID == issueID {
+					m.showModal = false
+					m.modalType = ""
+					m.roadmapDrill = 0
+					m.selectedIndex = i
+					m.updatePaneContent()
+					return m, m.loadDetail(issueID)
+				}
+			}
+		}
+		return m, nil
+	}
+
+	versions := m.roadmapVersions()
+	switch msg.String() {
+	case "esc", "q", "v":
+		m.showModal = false
+		m.modalType = ""
+	case "up", "k":
+		if m.roadmapCursor > 0 {
+			m.roadmapCursor--
+		}
+	case "down", "j":
+		if m.roadmapCursor < len(versions)-1 {
+			m.roadmapCursor++
+		}
+	case "c":
+		m.roadmapShowClosed = !m.roadmapShowClosed
+		m.roadmapCursor = 0
+		return m, m.fetchRoadmapIssues()
+	case "r":
+		// Reload counts, e.g. after changing issues
+		m.roadmapIssues = make(map[int][]api.Issue)
+		return m, m.fetchRoadmapIssues()
+	case "enter":
+		if m.roadmapCursor < len(versions) {
+			if _, ok := m.roadmapIssues[versions[m.roadmapCursor].ID]; ok {
+				m.roadmapDrill = versions[m.roadmapCursor].ID
+				m.roadmapIssueCursor = 0
+			}
+		}
+	}
+	return m, nil
+}
+
+// renderRoadmap renders the version list (or a version's issues) as a modal
+func (m Model) renderRoadmap() string {
+	if m.roadmapDrill != 0 {
+		return m.renderRoadmapIssues()
+	}
+
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	nameStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Title)).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	doneStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))
+	lateStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error)).Bold(true)
+
+	versions := m.roadmapVersions()
+	var lines []string
+	if len(versions) == 0 {
+		lines = append(lines, dimStyle.Render("No versions in the listed projects."))
+	}
+	// Two lines per version; keep the cursor in view
+	scroll := 0
+	if visible := 10; m.roadmapCursor >= visible {
+		scroll = m.roadmapCursor - visible + 1
+	}
+	multiProject := len(m.roadmapProjectIDs()) > 1
+	for i, v := range versions {
+		if i < scroll {
+			continue
+		}
+		prefix := "  "
+		name := nameStyle.Render(v.Name)
+		if i == m.roadmapCursor {
+			prefix = "→ "
+			name = cursorStyle.Render(v.Name)
+		}
+		head := prefix + name
+		if multiProject {
+			head += dimStyle.Render(" (" + v.Project.Name + ")")
+		}
+		if v.Status != "" && v.Status != "open" {
+			head += dimStyle.Render(" [" + v.Status + "]")
+		}
+		if v.DueDate != "" {
+			due := "due " + v.DueDate
+			if d, ok := parseDate(v.DueDate); ok && d.Before(today()) && v.Status != "closed" {
+				head += " " + lateStyle.Render(due+" · late")
+			} else {
+				head += " " + dimStyle.Render(due)
+			}
+		}
+
+		detail := dimStyle.Render("loading…")
+		if _, ok := m.roadmapIssues[v.ID]; ok {
+			open, closed, percent := m.versionProgress(v.ID)
+			filled := percent / 5
+			bar := doneStyle.Render(strings.Repeat("█", filled)) + dimStyle.Render(strings.Repeat("░", 20-filled))
+			detail = bar + " " + fmt.Sprintf("%3d%%  %d open · %d closed", percent, open, closed)
+		}
+		lines = append(lines, head, "    "+detail)
+	}
+
+	title := "Roadmap (Enter: issues, c: closed versions, Esc: close)"
+	if m.roadmapShowClosed {
+		title = "Roadmap incl. closed (Enter: issues, c: hide closed, Esc: close)"
+	}
+	return appui.RenderModal(appui.ModalConfig{
+		Title:       title,
+		Content:     lines,
+		Width:       m.width,
+		Height:      m.height,
+		BorderColor: appui.Theme.Success,
+		TitleColor:  appui.Theme.Title,
+	})
+}
+
+// renderRoadmapIssues renders the issues of the drilled-into version
+func (m Model) renderRoadmapIssues() string {
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	doneStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))
+
+	name := m.lookupName("version", strconv.Itoa(m.roadmapDrill))
+
+	listed := make(map[int]bool)
+	for _, issue := range m.getFilteredIssues() {
+		listed[issue.ID] = true
+	}
+
+	issues := m.roadmapIssues[m.roadmapDrill]
+	var lines []string
+	if len(issues) == 0 {
+		lines = append(lines, dimStyle.Render("No issues target this version."))
+	}
+	scroll := 0
+	if visible := 18; m.roadmapIssueCursor >= visible {
+		scroll = m.roadmapIssueCursor - visible + 1
+	}
+	for i, issue := range issues {
+		if i < scroll {
+			continue
+		}
+		mark := "  "
+		if m.isClosedStatus(issue.Status.ID) {
+			mark = doneStyle.Render("✓ ")
+		}
+		prefix := "  "
+		text := fmt.Sprintf("#%d %s", issue.ID, excerpt(issue.Subject, 36))
+		if i == m.roadmapIssueCursor {
+			prefix = "→ "
+			text = cursorStyle.Render(text)
+		}
+		status := issue.Status.Name
+		if !listed[issue.ID] {
+			status += " · not in list"
+		}
+		lines = append(lines, prefix+mark+text+" "+dimStyle.Render(status))
+	}
+
+	return appui.RenderModal(appui.ModalConfig{
+		Title:       fmt.Sprintf("%s · %d issues (Enter: open, Esc: back)", name, len(issues)),
+		Content:     lines,
+		Width:       m.width,
+		Height:      m.height,
+		BorderColor: appui.Theme.Success,
+		TitleColor:  appui.Theme.Title,
+	})
+}
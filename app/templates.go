@@ -0,0 +1,260 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	"github.com/ktsopanakis/redmine-tui/config"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// Note templates (canned responses) from templates.yaml in the config
+// directory. Ctrl+R in the note editor or the quick-actions note opens a
+// picker; the chosen template's text is inserted at the cursor with its
+// placeholders filled in, and its status/assignee (if any) are applied
+// together with the note.
+
+// openTemplatePicker (re)reads the templates so edits to the file apply
+// without a restart, and loads the lists templates may refer to
+func (m *Model) openTemplatePicker() tea.Cmd {
+	m.templatePick = true
+	m.templateCursor = 0
+	m.templateNotice = ""
+	templates, err := config.LoadTemplates()
+	if err != nil {
+		m.templateNotice = err.Error()
+	}
+	m.templates = templates
+
+	var cmds []tea.Cmd
+	for _, t := range templates {
+		if t.Status != "" && len(m.availableStatuses) == 0 && !m.lookupFetching[lookupKey("status", 0)] {
+			m.lookupFetching[lookupKey("status", 0)] = true
+			cmds = append(cmds, appui.SendLoadingMsg("Fetching statuses..."), fetchStatuses(m.client))
+		}
+		if t.Assignee != "" && len(m.availableUsers) == 0 && !m.lookupFetching[lookupKey("user", 0)] {
+			m.lookupFetching[lookupKey("user", 0)] = true
+			cmds = append(cmds, appui.SendLoadingMsg("Fetching users..."), fetchUsers(m.client))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// updateTemplatePicker handles keys while the picker is open: ↑/↓ or a
+// digit select, Enter inserts into ta, Esc closes. issueID is the issue the
+// note is for (0 for several marked issues).
+func (m *Model) updateTemplatePicker(msg tea.KeyMsg, ta *textarea.Model, issueID int) {
+	apply := -1
+	switch msg.String() {
+	case "esc", "ctrl+r":
+		m.templatePick = false
+	case "up", "k":
+		if m.templateCursor > 0 {
+			m.templateCursor--
+		}
+	case "down", "j":
+		if m.templateCursor < len(m.templates)-1 {
+			m.templateCursor++
+		}
+	case "enter":
+		apply = m.templateCursor
+	default:
+		if s := msg.String(); len(s) == 1 && s[0] >= '1' && s[0] <= '9' {
+			apply = int(s[0] - '1')
+		}
+	}
+	if apply < 0 || apply >= len(m.templates) {
+		return
+	}
+	t := m.templates[apply]
+	m.templatePick = false
+	ta.InsertString(m.expandTemplate(t.Text, issueID))
+	m.applyTemplateFields(t, issueID)
+}
+
+// templateIssue returns the issue a note is for, if it's a single one
+func (m *Model) templateIssue(issueID int) *api.Issue {
+	if issue, ok := m.detailCache[issueID]; ok {
+		return &issue
+	}
+	for i := range m.issues {
+		if m.issues[i].ID == issueID {
+			return &m.issues[i]
+		}
+	}
+	return nil
+}
+
+// expandTemplate fills in a template's placeholders. Issue placeholders are
+// left empty when the note goes to several issues.
+func (m *Model) expandTemplate(text string, issueID int) string {
+	var assignee, author, id, subject, me string
+	if issue := m.templateIssue(issueID); issue != nil {
+		if issue.AssignedTo != nil {
+			assignee = issue.AssignedTo.Name
+		}
+		author = issue.Author.Name
+		id = strconv.Itoa(issue.ID)
+		subject = issue.Subject
+	}
+	if m.currentUser != nil {
+		me = userDisplayName(*m.currentUser)
+	}
+	return strings.NewReplacer(
+		"{{assignee}}", assignee,
+		"{{author}}", author,
+		"{{id}}", id,
+		"{{subject}}", subject,
+		"{{me}}", me,
+		"{{date}}", today().Format("2006-01-02"),
+	).Replace(text)
+}
+
+// templateAssignee resolves a template's assignee to a user ID: "author",
+// "me", "nobody" (0) or a login/name from the user list
+func (m *Model) templateAssignee(value string, issueID int) (int, bool) {
+	switch strings.ToLower(value) {
+	case "author":
+		if issue := m.templateIssue(issueID); issue != nil {
+			return issue.Author.ID, true
+		}
+		return 0, false
+	case "me":
+		if m.currentUser != nil {
+			return m.currentUser.ID, true
+		}
+		return 0, false
+	case "nobody", "none":
+		return 0, true
+	}
+	for _, u := range m.availableUsers {
+		if strings.EqualFold(u.Login, value) || strings.EqualFold(userDisplayName(u), value) {
+			return u.ID, true
+		}
+	}
+	return 0, false
+}
+
+// applyTemplateFields applies a template's status and assignee: in the
+// quick-actions popup by selecting them, in the note editor by adding them
+// to the note's update. Values that can't be resolved are reported, and so
+// are field changes while editing an existing note, which can't carry them.
+func (m *Model) applyTemplateFields(t config.NoteTemplate, issueID int) {
+	if m.noteMode && m.noteJournal != 0 && !m.quickMode {
+		if t.Status != "" || t.Assignee != "" {
+			m.templateNotice = "Template " + t.Name + ": status and assignee aren't changed when editing a note"
+		}
+		return
+	}
+	if m.noteUpdates == nil {
+		m.noteUpdates = make(map[string]interface{})
+	}
+	var unknown []string
+	if t.Status != "" {
+		statusIdx := -1
+		for i, st := range m.availableStatuses {
+			if strings.EqualFold(st.Name, t.Status) {
+				statusIdx = i
+				break
+			}
+		}
+		switch {
+		case statusIdx < 0:
+			unknown = append(unknown, fmt.Sprintf("status %q", t.Status))
+		case m.quickMode:
+			m.quickStatusIdx = statusIdx
+		default:
+			m.noteUpdates["status_id"] = m.availableStatuses[statusIdx].ID
+		}
+	}
+	if t.Assignee != "" {
+		userID, ok := m.templateAssignee(t.Assignee, issueID)
+		switch {
+		case !ok:
+			unknown = append(unknown, fmt.Sprintf("assignee %q", t.Assignee))
+		case m.quickMode:
+			m.quickAssigneeFilter = ""
+			for i, o := range m.quickFilteredAssignees() {
+				if o.ID == userID {
+					m.quickAssigneeSel = i
+					break
+				}
+			}
+		case userID == 0:
+			m.noteUpdates["assigned_to_id"] = nil
+		default:
+			m.noteUpdates["assigned_to_id"] = userID
+		}
+	}
+	if len(unknown) > 0 {
+		m.templateNotice = "Template " + t.Name + ": unknown " + strings.Join(unknown, ", ")
+	}
+}
+
+// noteUpdateSummary describes the changes a template added to the note,
+// e.g. "status → Feedback, assignee → Alice"
+func (m *Model) noteUpdateSummary() string {
+	var parts []string
+	if id, ok := m.noteUpdates["status_id"]; ok {
+		parts = append(parts, "status → "+m.lookupName("status", fmt.Sprint(id)))
+	}
+	if id, ok := m.noteUpdates["assigned_to_id"]; ok {
+		name := "nobody"
+		if id != nil {
+			name = m.lookupName("user", fmt.Sprint(id))
+		}
+		parts = append(parts, "assignee → "+name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// renderTemplatePicker renders the template list (or the last template
+// problem) shown below the editor, or "" when there is nothing to show
+func (m Model) renderTemplatePicker() string {
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	noticeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error))
+	if !m.templatePick {
+		if m.templateNotice != "" {
+			return noticeStyle.Render(m.templateNotice)
+		}
+		return ""
+	}
+
+	var lines []string
+	if m.templateNotice != "" {
+		lines = append(lines, noticeStyle.Render(m.templateNotice))
+	}
+	if len(m.templates) == 0 && m.templateNotice == "" {
+		path, _ := config.GetTemplatesPath()
+		lines = append(lines, dimStyle.Render("No templates yet. Add them to"), dimStyle.Render(path))
+	}
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	for i, t := range m.templates {
+		prefix := "  "
+		name := fmt.Sprintf("%d. %s", i+1, t.Name)
+		if i == m.templateCursor {
+			prefix = "→ "
+			name = cursorStyle.Render(name)
+		}
+		var sets []string
+		if t.Status != "" {
+			sets = append(sets, t.Status)
+		}
+		if t.Assignee != "" {
+			sets = append(sets, "assign to "+t.Assignee)
+		}
+		line := prefix + name
+		if len(sets) > 0 {
+			line += " " + dimStyle.Render("→ "+strings.Join(sets, ", "))
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, dimStyle.Render("↑↓/1-9: select   Enter: insert   Esc: close"))
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,539 @@
+package app
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+const (
+	dateLayout         = "2006-01-02" // Redmine's date format
+	timelineCellWidth  = 3            // characters per axis cell
+	timelineLabelWidth = 30           // width of the "#ID subject" column
+	timelineLeadDays   = 3            // days shown before today/the issue when (re)centering
+)
+
+// timelineCellDays is how many days one axis cell covers per scale
+var timelineCellDays = map[string]int{"day": 1, "week": 7}
+
+// parseDate parses a Redmine date in local time
+func parseDate(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation(dateLayout, s, time.Local)
+	return t, err == nil
+}
+
+// today returns the start of the current local day
+func today() time.Time {
+	y, mo, d := time.Now().Date()
+	return time.Date(y, mo, d, 0, 0, 0, 0, time.Local)
+}
+
+// daysBetween returns the number of calendar days from a to b
+func daysBetween(a, b time.Time) int {
+	return int(math.Round(b.Sub(a).Hours() / 24))
+}
+
+// timelineCell returns the axis cell a day falls in (negative = off the left)
+func (m *Model) timelineCell(day time.Time) int {
+	days := daysBetween(m.timelineStart, day)
+	n := timelineCellDays[m.timelineScale]
+	if days < 0 {
+		return (days - n + 1) / n
+	}
+	return days / n
+}
+
+// predecessors maps issue IDs to the issues that precede or block them,
+// from whatever relations have been loaded with issue details
+func (m *Model) predecessors() map[int][]int {
+	out := make(map[int][]int)
+	seen := make(map[int]bool)
+	for _, issue := range m.issues {
+		for _, rel := range issue.Relations {
+			if seen[rel.ID] || (rel.RelationType != "precedes" && rel.RelationType != "blocks") {
+				continue
+			}
+			seen[rel.ID] = true
+			out[rel.IssueToID] = append(out[rel.IssueToID], rel.IssueID)
+		}
+	}
+	return out
+}
+
+// openTimeline switches to the timeline, starting on the selected issue
+func (m *Model) openTimeline() tea.Cmd {
+	m.timelineMode = true
+	m.viewNotice = ""
+	if m.timelineScale == "" {
+		m.timelineScale = "day"
+	}
+	m.timelineRow = m.selectedIndex
+	m.centerTimeline()
+	if len(m.availableStatuses) == 0 {
+		return tea.Batch(appui.SendLoadingMsg("Fetching statuses..."), fetchStatuses(m.client))
+	}
+	return nil
+}
+
+// centerTimeline scrolls the axis to the selected issue's start (or due)
+// date, or to today if it has no dates
+func (m *Model) centerTimeline() {
+	day := today()
+	issues := m.getFilteredIssues()
+	if m.timelineRow >= 0 && m.timelineRow < len(issues) {
+		issue := issues[m.timelineRow]
+		if start, ok := parseDate(issue.StartDate); ok {
+			day = start
+		} else if due, ok := parseDate(issue.DueDate); ok {
+			day = due
+		}
+	}
+	m.timelineStart = day.AddDate(0, 0, -timelineLeadDays*timelineCellDays[m.timelineScale])
+}
+
+// closeTimeline leaves the timeline, dropping unsaved date shifts
+func (m *Model) closeTimeline() {
+	m.discardTimelineShifts()
+	m.timelineMode = false
+	m.updatePaneContent()
+}
+
+// discardTimelineShifts restores issues whose dates were shifted but not saved
+func (m *Model) discardTimelineShifts() {
+	for i, issue := range m.issues {
+		if orig, ok := m.timelinePending[issue.ID]; ok {
+			m.issues[i] = orig
+		}
+	}
+	m.timelinePending = make(map[int]api.Issue)
+}
+
+// updateTimeline handles the timeline's own keys. It reports false for keys
+// the timeline does not use, which then fall through to the normal bindings.
+func (m *Model) updateTimeline(msg tea.KeyMsg) (tea.Cmd, bool) {
+	m.viewNotice = ""
+	issues := m.getFilteredIssues()
+	n := timelineCellDays[m.timelineScale]
+
+	if key.Matches(msg, m.keys.Timeline) {
+		m.closeTimeline()
+		return nil, true
+	}
+
+	switch msg.String() {
+	case "esc":
+		if len(m.timelinePending) > 0 {
+			m.discardTimelineShifts()
+			return nil, true
+		}
+		m.closeTimeline()
+		return nil, true
+	case "enter", "e":
+		// Back to the list/details panes on the selected issue
+		m.closeTimeline()
+		if m.timelineRow >= 0 && m.timelineRow < len(issues) {
+			m.selectedIndex = m.timelineRow
+			m.updatePaneContent()
+			return m.loadDetail(issues[m.timelineRow].ID), true
+		}
+		return nil, true
+	case "up", "k":
+		if m.timelineRow > 0 {
+			m.timelineRow--
+		}
+	case "down", "j":
+		if m.timelineRow < len(issues)-1 {
+			m.timelineRow++
+		}
+	case "left", "h":
+		m.timelineStart = m.timelineStart.AddDate(0, 0, -n)
+	case "right", "l":
+		m.timelineStart = m.timelineStart.AddDate(0, 0, n)
+	case "pgup":
+		m.timelineStart = m.timelineStart.AddDate(0, 0, -n*10)
+	case "pgdown":
+		m.timelineStart = m.timelineStart.AddDate(0, 0, n*10)
+	case ".":
+		m.timelineStart = today().AddDate(0, 0, -timelineLeadDays*n)
+	case "g":
+		m.centerTimeline()
+	case "w":
+		if m.timelineScale == "day" {
+			m.timelineScale = "week"
+		} else {
+			m.timelineScale = "day"
+		}
+	case "H", "shift+left":
+		m.shiftTimelineDates(issues, -1, -1)
+	case "L", "shift+right":
+		m.shiftTimelineDates(issues, 1, 1)
+	case "[":
+		m.shiftTimelineDates(issues, -1, 0)
+	case "]":
+		m.shiftTimelineDates(issues, 1, 0)
+	case "<":
+		m.shiftTimelineDates(issues, 0, -1)
+	case ">":
+		m.shiftTimelineDates(issues, 0, 1)
+	case "ctrl+s":
+		return m.saveTimelineShifts(), true
+	default:
+		return nil, false
+	}
+
+	if m.timelineRow >= 0 && m.timelineRow < len(issues) {
+		m.selectedIndex = m.timelineRow
+	}
+	return nil, true
+}
+
+// shiftTimelineDates moves the selected issue's start and/or due date by
+// the given number of days, locally until saved. A missing date is left
+// alone, and the start may not pass the due date.
+func (m *Model) shiftTimelineDates(issues []api.Issue, startDays, dueDays int) {
+	if m.timelineRow < 0 || m.timelineRow >= len(issues) {
+		return
+	}
+	id := issues[m.timelineRow].ID
+	for i := range m.issues {
+		if m.issues[i].ID != id {
+			continue
+		}
+		issue := m.issues[i]
+		start, hasStart := parseDate(issue.StartDate)
+		due, hasDue := parseDate(issue.DueDate)
+		if !hasStart && !hasDue {
+			m.viewNotice = fmt.Sprintf("#%d has no dates to shift; set them in edit mode", id)
+			return
+		}
+		if hasStart {
+			start = start.AddDate(0, 0, startDays)
+		}
+		if hasDue {
+			due = due.AddDate(0, 0, dueDays)
+		}
+		if hasStart && hasDue && due.Before(start) {
+			m.viewNotice = "The start date can't be after the due date"
+			return
+		}
+
+		if _, ok := m.timelinePending[id]; !ok {
+			m.timelinePending[id] = issue
+		}
+		if hasStart {
+			m.issues[i].StartDate = start.Format(dateLayout)
+		}
+		if hasDue {
+			m.issues[i].DueDate = due.Format(dateLayout)
+		}
+		return
+	}
+}
+
+// timelineSave is one issue's date change in a timeline save
+type timelineSave struct {
+	issueID int
+	updates map[string]interface{}
+	change  *changeRecord
+}
+
+// timelineSavedMsg reports a timeline save once every update has finished
+type timelineSavedMsg struct {
+	saved  []*changeRecord // previous values of the saved issues, for undo
+	failed int
+	err    error // the first failure
+}
+
+// saveTimelineShifts sends the shifted dates of every changed issue as one
+// batch; the list is reloaded once they have all finished
+func (m *Model) saveTimelineShifts() tea.Cmd {
+	if len(m.timelinePending) == 0 {
+		return nil
+	}
+	var saves []timelineSave
+	for _, issue := range m.issues {
+		orig, ok := m.timelinePending[issue.ID]
+		if !ok {
+			continue
+		}
+		updates := make(map[string]interface{})
+		if issue.StartDate != orig.StartDate {
+			updates["start_date"] = issue.StartDate
+		}
+		if issue.DueDate != orig.DueDate {
+			updates["due_date"] = issue.DueDate
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		saves = append(saves, timelineSave{issueID: issue.ID, updates: updates, change: m.recordChangeFrom(orig, updates)})
+	}
+	m.timelinePending = make(map[int]api.Issue)
+	if len(saves) == 0 {
+		return nil
+	}
+	m.loading = true
+	return tea.Batch(appui.SendLoadingMsg("Saving dates..."), saveTimelineDates(m.client, saves))
+}
+
+// saveTimelineDates sends the date changes one after another
+func saveTimelineDates(client *api.Client, saves []timelineSave) tea.Cmd {
+	return func() tea.Msg {
+		var msg timelineSavedMsg
+		for _, save := range saves {
+			if err := client.UpdateIssue(save.issueID, save.updates); err != nil {
+				msg.failed++
+				if msg.err == nil {
+					msg.err = fmt.Errorf("#%d: %w", save.issueID, err)
+				}
+				continue
+			}
+			if save.change != nil {
+				msg.saved = append(msg.saved, save.change)
+			}
+		}
+		return msg
+	}
+}
+
+// finishTimelineSave logs the saved changes, reports failures and reloads
+// the list once, which also puts failed bars back where the server has them
+func (m *Model) finishTimelineSave(msg timelineSavedMsg) tea.Cmd {
+	for _, change := range msg.saved {
+		m.logChange(change)
+	}
+	if msg.err != nil {
+		m.viewNotice = fmt.Sprintf("%d date change(s) failed: %v", msg.failed, msg.err)
+	}
+	m.loading = true
+	return tea.Batch(
+		appui.SendLoadingCompleteMsg(),
+		appui.SendLoadingMsg("Refreshing issues..."),
+		fetchIssues(m.client, m.viewMode, m.assigneeFilter, m.projectFilter, m.issues),
+	)
+}
+
+// renderTimeline renders the timeline in place of the list and details panes
+func (m Model) renderTimeline() string {
+	width := m.width - 4
+	height := m.leftPane.Height
+	labelWidth := timelineLabelWidth
+	if labelWidth > width/3 {
+		labelWidth = width / 3
+	}
+	cells := (width - labelWidth - 1) / timelineCellWidth
+	if cells < 1 {
+		cells = 1
+	}
+	n := timelineCellDays[m.timelineScale]
+	todayCell := m.timelineCell(today())
+
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	todayStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	monthStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Special)).Bold(true)
+	idStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent)).Bold(true)
+	selectedStyle := appui.SelectedStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+
+	// Axis: month names where a month starts, then the day of each cell
+	months := []rune(strings.Repeat(" ", cells*timelineCellWidth))
+	var days strings.Builder
+	for c := 0; c < cells; c++ {
+		day := m.timelineStart.AddDate(0, 0, c*n)
+		if c == 0 || day.Day() <= n {
+			copy(months[c*timelineCellWidth:], []rune(day.Format("Jan 2006")))
+		}
+		label := fmt.Sprintf("%2d ", day.Day())
+		if c == todayCell {
+			days.WriteString(todayStyle.Render(label))
+		} else if m.timelineScale == "day" && (day.Weekday() == time.Saturday || day.Weekday() == time.Sunday) {
+			days.WriteString(dimStyle.Render(label))
+		} else {
+			days.WriteString(label)
+		}
+	}
+	pad := strings.Repeat(" ", labelWidth+1)
+	lines := []string{
+		pad + monthStyle.Render(string(months[:cells*timelineCellWidth])),
+		pad + days.String(),
+	}
+
+	issues := m.getFilteredIssues()
+	deps := m.predecessors()
+	rows := height - len(lines) - 2 // room for the info line
+	first := 0
+	if rows > 0 && m.timelineRow >= rows {
+		first = m.timelineRow - rows + 1
+	}
+	for r := first; r < len(issues) && r < first+rows; r++ {
+		issue := issues[r]
+		id := fmt.Sprintf("#%d", issue.ID)
+		// Narrow terminals leave no room for the subject, or even the ID
+		label := id
+		if room := labelWidth - len(id) - 1; room >= 2 {
+			label += " " + excerpt(issue.Subject, room)
+		} else if len(id) > labelWidth {
+			id = excerpt(id, max(labelWidth, 1))
+			label = id
+		}
+		label += strings.Repeat(" ", max(0, labelWidth-lipgloss.Width(label)))
+		if r == m.timelineRow {
+			label = selectedStyle.Render(label)
+		} else {
+			label = idStyle.Render(id) + label[len(id):]
+		}
+		lines = append(lines, label+" "+m.renderTimelineBar(issue, deps[issue.ID], cells, todayCell))
+	}
+	for len(lines) < height-2 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, "", m.timelineInfo(issues))
+
+	content := lipgloss.NewStyle().Width(width).Height(height).MaxHeight(height).Render(strings.Join(lines, "\n"))
+	return appui.RenderPane(appui.PaneConfig{
+		Content:  content,
+		Title:    fmt.Sprintf("Timeline (%s) from %s", m.timelineScale, m.timelineStart.Format("Jan 2")),
+		Width:    width,
+		IsActive: true,
+		ShowDot:  true,
+	})
+}
+
+// renderTimelineBar draws one issue's row: its bar (or start/due marker),
+// a connector from the end of any loaded predecessor, and the today marker.
+func (m Model) renderTimelineBar(issue api.Issue, preds []int, cells, todayCell int) string {
+	start, hasStart := parseDate(issue.StartDate)
+	due, hasDue := parseDate(issue.DueDate)
+	closed := m.isClosedStatus(issue.Status.ID)
+
+	color := appui.Theme.Accent // scheduled
+	switch {
+	case m.timelinePending[issue.ID].ID != 0:
+		color = appui.Theme.Warning // unsaved shift
+	case closed:
+		color = appui.Theme.Success // done
+	case hasDue && due.Before(today()):
+		color = appui.Theme.Error // overdue
+	}
+	barStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+	depStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Special))
+	todayStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor))
+
+	startCell, dueCell := -1, -1
+	if hasStart {
+		startCell = m.timelineCell(start)
+	}
+	if hasDue {
+		dueCell = m.timelineCell(due)
+	}
+
+	// Connector from the latest-ending predecessor to this issue's start
+	depFrom := -1
+	if hasStart {
+		for _, pid := range preds {
+			for _, p := range m.issues {
+				if p.ID != pid {
+					continue
+				}
+				if pdue, ok := parseDate(p.DueDate); ok {
+					if c := m.timelineCell(pdue); c > depFrom && c < startCell {
+						depFrom = c
+					}
+				}
+			}
+		}
+	}
+
+	blank := strings.Repeat(" ", timelineCellWidth)
+	full := strings.Repeat("█", timelineCellWidth)
+	var b strings.Builder
+	for c := 0; c < cells; c++ {
+		switch {
+		case hasStart && hasDue && c >= startCell && c <= dueCell:
+			b.WriteString(barStyle.Render(full))
+		case hasStart && !hasDue && c == startCell:
+			b.WriteString(barStyle.Render(" ▶ "))
+		case hasDue && !hasStart && c == dueCell:
+			b.WriteString(barStyle.Render(" ◆ "))
+		case depFrom >= 0 && c > depFrom && c == startCell-1:
+			b.WriteString(depStyle.Render("┈┈▸"))
+		case depFrom >= 0 && c > depFrom && c < startCell:
+			b.WriteString(depStyle.Render("┈┈┈"))
+		case c == todayCell:
+			b.WriteString(todayStyle.Render(" │ "))
+		default:
+			b.WriteString(blank)
+		}
+	}
+	return b.String()
+}
+
+// timelineInfo describes the selected issue's dates and dependencies
+func (m Model) timelineInfo(issues []api.Issue) string {
+	if m.timelineRow < 0 || m.timelineRow >= len(issues) {
+		return ""
+	}
+	issue := issues[m.timelineRow]
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Error)).Bold(true)
+	pendingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning)).Bold(true)
+
+	startText, dueText := issue.StartDate, issue.DueDate
+	if startText == "" {
+		startText = "no start"
+	}
+	if dueText == "" {
+		dueText = "no due date"
+	}
+	parts := []string{fmt.Sprintf("#%d %s → %s", issue.ID, startText, dueText)}
+	start, hasStart := parseDate(issue.StartDate)
+	due, hasDue := parseDate(issue.DueDate)
+	if hasStart && hasDue {
+		parts = append(parts, fmt.Sprintf("%d days", daysBetween(start, due)+1))
+	}
+	if preds := m.predecessors()[issue.ID]; len(preds) > 0 {
+		ids := make([]string, len(preds))
+		for i, id := range preds {
+			ids[i] = fmt.Sprintf("#%d", id)
+		}
+		parts = append(parts, "after "+strings.Join(ids, ", "))
+	}
+	info := dimStyle.Render(strings.Join(parts, " · "))
+	if hasDue && due.Before(today()) && !m.isClosedStatus(issue.Status.ID) {
+		info += " " + warnStyle.Render("overdue")
+	}
+	if len(m.timelinePending) > 0 {
+		info += " " + pendingStyle.Render(fmt.Sprintf("· %d unsaved (Ctrl+S: save, Esc: discard)", len(m.timelinePending)))
+	}
+	return info
+}
+
+// getTimelineFooterItems returns footer menu items for the timeline
+func (m Model) getTimelineFooterItems() []appui.FooterItem {
+	items := []appui.FooterItem{
+		{Text: "↑↓: Issue", Required: true},
+		{Text: "←→: Scroll", Required: true},
+		{Text: "H/L: Shift dates", Required: true},
+		{Text: "[ ]: Start", Required: false},
+		{Text: "< >: Due", Required: false},
+		{Text: "Ctrl+S: Save", Required: true},
+		{Text: "w: " + map[string]string{"day": "Weeks", "week": "Days"}[m.timelineScale], Required: false},
+		{Text: ".: Today", Required: false},
+		{Text: "g: Go to issue", Required: false},
+		{Text: "t/Esc: List", Required: true},
+	}
+	if m.viewNotice != "" {
+		items = append([]appui.FooterItem{{Text: m.viewNotice, Required: true}}, items...)
+	}
+	return items
+}
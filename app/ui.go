@@ -16,22 +16,41 @@ func (m Model) View() string {
 
 	// Build header sections
 	leftSections := []appui.HeaderSection{
-		{Text: "◆", Color: "#FFFFFF", Bold: true},
-		{Text: config.Current.Redmine.URL, Color: "#FFD700", Bold: true},
+		{Text: "◆", Color: appui.Theme.Title, Bold: true},
+		{Text: config.Current.Redmine.URL, Color: appui.Theme.Cursor, Bold: true},
+	}
+	if m.boardMode {
+		leftSections = append(leftSections, appui.HeaderSection{Text: "Board: " + m.boardTitle(), Color: appui.Theme.Accent, Bold: false})
+	} else if m.timelineMode {
+		leftSections = append(leftSections, appui.HeaderSection{Text: "Timeline: " + m.leftTitle, Color: appui.Theme.Accent, Bold: false})
 	}
 
 	dayOfWeek, dateTime := appui.FormatDateTime()
 	rightSections := []appui.HeaderSection{}
 
+	if n := m.unseenCount(); n > 0 {
+		rightSections = append(rightSections,
+			appui.HeaderSection{Text: fmt.Sprintf("● %d updated", n), Color: appui.Theme.Warning, Bold: true},
+			appui.HeaderSection{Text: "|", Color: appui.Theme.Muted, Bold: false},
+		)
+	}
+
+	if n := m.unreadNotifications(); n > 0 {
+		rightSections = append(rightSections,
+			appui.HeaderSection{Text: fmt.Sprintf("✉ %d", n), Color: appui.Theme.Error, Bold: true},
+			appui.HeaderSection{Text: "|", Color: appui.Theme.Muted, Bold: false},
+		)
+	}
+
 	if m.currentUser != nil {
 		rightSections = append(rightSections,
-			appui.HeaderSection{Text: m.currentUser.Name, Color: "#C678DD", Bold: false},
-			appui.HeaderSection{Text: "|", Color: "#666666", Bold: false},
+			appui.HeaderSection{Text: m.currentUser.Name, Color: appui.Theme.Special, Bold: false},
+			appui.HeaderSection{Text: "|", Color: appui.Theme.Muted, Bold: false},
 		)
 	}
 	rightSections = append(rightSections,
-		appui.HeaderSection{Text: dayOfWeek, Color: "#61AFEF", Bold: false},
-		appui.HeaderSection{Text: dateTime, Color: "#98C379", Bold: false},
+		appui.HeaderSection{Text: dayOfWeek, Color: appui.Theme.Accent, Bold: false},
+		appui.HeaderSection{Text: dateTime, Color: appui.Theme.Success, Bold: false},
 	)
 
 	header := appui.RenderHeader(leftSections, rightSections, m.width)
@@ -63,7 +82,7 @@ func (m Model) View() string {
 	// Render right pane with custom color if editing with unsaved changes
 	rightCustomColor := ""
 	if m.editMode && m.hasUnsavedChanges {
-		rightCustomColor = "#E06C75" // Red border for unsaved changes
+		rightCustomColor = appui.Theme.Error // Border for unsaved changes
 	}
 
 	rightPane := appui.RenderPaneWithColoredTitle(appui.PaneConfig{
@@ -73,10 +92,15 @@ func (m Model) View() string {
 		IsActive:    m.activePane == 1,
 		ShowDot:     m.activePane == 1,
 		CustomColor: rightCustomColor,
-	}, "#FFFFFF") // White title color
+	}, appui.Theme.Title)
 
 	// Combine panes side by side
 	panes := appui.CombinePanes(leftPane, rightPane)
+	if m.boardMode {
+		panes = m.renderBoard()
+	} else if m.timelineMode {
+		panes = m.renderTimeline()
+	}
 
 	// If in list selection mode, overlay the list on top
 	if m.userInputMode == "user" || m.userInputMode == "project" {
@@ -84,6 +108,11 @@ func (m Model) View() string {
 		panes = appui.OverlayOnContent(panes, listOverlay)
 	}
 
+	// If the command palette is open, overlay it on top
+	if m.paletteMode {
+		panes = appui.OverlayOnContent(panes, m.renderPalette())
+	}
+
 	// If note mode is active, overlay the note input on top
 	if m.noteMode {
 		panes = appui.OverlayOnContent(panes, m.renderNoteOverlay())
@@ -104,12 +133,35 @@ func (m Model) View() string {
 		panes = appui.OverlayOnContent(panes, m.renderQuickActions())
 	}
 
+	// If the API key prompt is open, overlay it on top
+	if m.apiKeyMode {
+		panes = appui.OverlayOnContent(panes, m.renderAPIKeyPrompt())
+	}
+
 	// If modal is active, overlay the modal on top
 	if m.showModal {
 		var modal string
 		switch m.modalType {
 		case "help":
 			modal = m.renderHelpModal()
+		case "notifications":
+			modal = m.renderNotificationCenter()
+		case "bulk":
+			modal = m.renderBulkProgress()
+		case "changes":
+			modal = m.renderChangeLog()
+		case "calendar":
+			modal = m.renderCalendar()
+		case "roadmap":
+			modal = m.renderRoadmap()
+		case "history":
+			modal = m.renderHistory()
+		case "yank":
+			modal = m.renderYankMenu()
+		case "browser":
+			modal = m.renderBrowserMenu()
+		case "keys":
+			modal = m.renderKeyProblems()
 		}
 		if modal != "" {
 			panes = appui.OverlayOnContent(panes, modal)
@@ -126,10 +178,14 @@ func (m Model) View() string {
 
 	// Footer with adaptive options
 	var footer string
-	if m.filterMode {
-		footer = appui.RenderPromptFooter("Filter: ", m.filterInput.View(), m.width, "#61AFEF")
+	if m.apiKeyMode {
+		footer = appui.RenderFooter("Enter: Save key and retry  |  Esc: Cancel", m.width)
+	} else if m.paletteMode {
+		footer = appui.RenderPromptFooter(":", m.paletteInput.View(), m.width, appui.Theme.Special)
+	} else if m.filterMode {
+		footer = appui.RenderPromptFooter("Filter: ", m.filterInput.View(), m.width, appui.Theme.Accent)
 	} else if m.noteMode {
-		footer = appui.RenderFooter("Ctrl+S: Post note  |  Esc: Cancel", m.width)
+		footer = appui.RenderFooter("Ctrl+S: Post note  |  Ctrl+O: Private  |  Esc: Cancel", m.width)
 	} else if m.descEditMode {
 		footer = appui.RenderFooter("Ctrl+S: Save description  |  Esc: Cancel", m.width)
 	} else if m.statusPickMode {
@@ -139,9 +195,15 @@ func (m Model) View() string {
 	} else if m.editMode {
 		footer = appui.RenderFooter(m.renderEditFooter(), m.width)
 	} else if m.userInputMode == "user" {
-		footer = appui.RenderPromptFooter("Filter Users: ", m.filterInput.View(), m.width, "#61AFEF")
+		footer = appui.RenderPromptFooter("Filter Users: ", m.filterInput.View(), m.width, appui.Theme.Accent)
 	} else if m.userInputMode == "project" {
-		footer = appui.RenderPromptFooter("Filter Projects: ", m.filterInput.View(), m.width, "#98C379")
+		footer = appui.RenderPromptFooter("Filter Projects: ", m.filterInput.View(), m.width, appui.Theme.Success)
+	} else if m.boardMode {
+		menuText := appui.BuildAdaptiveMenu(m.getBoardFooterItems(), m.width-2, " | ")
+		footer = appui.RenderFooter(menuText, m.width)
+	} else if m.timelineMode {
+		menuText := appui.BuildAdaptiveMenu(m.getTimelineFooterItems(), m.width-2, " | ")
+		footer = appui.RenderFooter(menuText, m.width)
 	} else {
 		menuText := appui.BuildAdaptiveMenu(m.getFooterItems(), m.width-2, " | ")
 		footer = appui.RenderFooter(menuText, m.width)
@@ -158,36 +220,54 @@ func (m Model) View() string {
 
 // renderNoteOverlay renders the add-note input as a centered modal
 func (m Model) renderNoteOverlay() string {
+	title := fmt.Sprintf("Add note to #%d", m.noteIssueID)
+	hint := "Ctrl+S: Post   Ctrl+O: Private   Ctrl+R: Template   Esc: Cancel"
+	if m.noteJournal != 0 {
+		title = fmt.Sprintf("Edit note on #%d", m.noteIssueID)
+		hint = "Ctrl+S: Save (empty deletes)   Ctrl+O: Private   Ctrl+R: Template   Esc: Cancel"
+	}
+	if m.notePrivate {
+		title += " · private"
+	}
+	body := withList(m.noteInput.View(), m.renderCompletion())
+	body = withList(body, m.renderTemplatePicker())
+	if summary := m.noteUpdateSummary(); summary != "" && m.noteJournal == 0 {
+		body = withList(body, lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Warning)).Render("With the note: "+summary))
+	}
 	return appui.RenderInputModal(appui.InputModalConfig{
-		Title:       fmt.Sprintf("Add note to #%d", m.noteIssueID),
-		Body:        m.noteInput.View(),
-		Hint:        "Ctrl+S: Post   Esc: Cancel",
+		Title:       title,
+		Body:        body,
+		Hint:        hint,
 		Width:       m.width,
 		Height:      m.height,
-		BorderColor: "#98C379",
-		TitleColor:  "#FFFFFF",
+		BorderColor: appui.Theme.Success,
+		TitleColor:  appui.Theme.Title,
 		BoxWidth:    66,
 	})
 }
 
 // renderQuickActions renders the combined status/assignee/note popup
 func (m Model) renderQuickActions() string {
-	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#61AFEF")).Bold(true)
-	activeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700")).Bold(true)
-	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF"))
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent)).Bold(true)
+	activeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Title))
+
+	focused := m.quickFieldName()
+	render := func(field, text string) string {
+		if focused == field {
+			return activeStyle.Render(text)
+		}
+		return valueStyle.Render(text)
+	}
 
 	// Status row
 	statusName := "(none)"
-	if len(m.availableStatuses) > 0 && m.quickStatusIdx < len(m.availableStatuses) {
+	if m.quickStatusIdx < 0 {
+		statusName = "(no change)"
+	} else if len(m.availableStatuses) > 0 && m.quickStatusIdx < len(m.availableStatuses) {
 		statusName = m.availableStatuses[m.quickStatusIdx].Name
 	}
-	statusVal := "‹ " + statusName + " ›"
-	if m.quickField == 0 {
-		statusVal = activeStyle.Render(statusVal)
-	} else {
-		statusVal = valueStyle.Render(statusVal)
-	}
-	statusLine := labelStyle.Render("Status:   ") + statusVal
+	statusLine := labelStyle.Render("Status:   ") + render("status", "‹ "+statusName+" ›")
 
 	// Assignee row (type-to-filter)
 	opts := m.quickFilteredAssignees()
@@ -196,7 +276,7 @@ func (m Model) renderQuickActions() string {
 		selName = opts[m.quickAssigneeSel].Name
 	}
 	filterPart := m.quickAssigneeFilter
-	if m.quickField == 1 {
+	if focused == "assignee" {
 		filterPart += "▌"
 	}
 	var assigneeVal string
@@ -205,31 +285,54 @@ func (m Model) renderQuickActions() string {
 	} else {
 		assigneeVal = selName
 	}
-	if m.quickField == 1 {
-		assigneeVal = activeStyle.Render(assigneeVal)
-	} else {
-		assigneeVal = valueStyle.Render(assigneeVal)
+	assigneeLine := labelStyle.Render("Assignee: ") + render("assignee", assigneeVal)
+
+	body := statusLine + "\n" + assigneeLine
+
+	// Priority and version rows (bulk mode only)
+	if len(m.quickBulkIDs) > 0 {
+		priorityName := "(no change)"
+		if m.quickPriorityIdx >= 0 && m.quickPriorityIdx < len(m.availablePriorities) {
+			priorityName = m.availablePriorities[m.quickPriorityIdx].Name
+		}
+		versionName := "(no change)"
+		if versions := m.quickVersionOptions(); m.quickVersionIdx >= 0 && m.quickVersionIdx < len(versions) {
+			versionName = versions[m.quickVersionIdx].Name
+		}
+		body += "\n" + labelStyle.Render("Priority: ") + render("priority", "‹ "+priorityName+" ›")
+		body += "\n" + labelStyle.Render("Version:  ") + render("version", "‹ "+versionName+" ›")
 	}
-	assigneeLine := labelStyle.Render("Assignee: ") + assigneeVal
 
 	// Note row
 	noteLabel := "Note:"
-	if m.quickField == 2 {
+	if m.quickNotePrivate {
+		noteLabel = "Note (private):"
+	}
+	if focused == "note" {
 		noteLabel = activeStyle.Render(noteLabel)
 	} else {
 		noteLabel = labelStyle.Render(noteLabel)
 	}
 
-	body := statusLine + "\n" + assigneeLine + "\n\n" + noteLabel + "\n" + m.quickNote.View()
+	body += "\n\n" + noteLabel + "\n" + m.quickNote.View()
+	if focused == "note" {
+		body = withList(body, m.renderCompletion())
+		body = withList(body, m.renderTemplatePicker())
+	}
+
+	title := fmt.Sprintf("Quick actions · #%d", m.quickIssueID)
+	if len(m.quickBulkIDs) > 0 {
+		title = fmt.Sprintf("Quick actions · %d marked issues", len(m.quickBulkIDs))
+	}
 
 	return appui.RenderInputModal(appui.InputModalConfig{
-		Title:       fmt.Sprintf("Quick actions · #%d", m.quickIssueID),
+		Title:       title,
 		Body:        body,
-		Hint:        "Tab: next field   ←/→: change   type: filter assignee   Ctrl+S: apply   Esc: cancel",
+		Hint:        "Tab: next field   ←/→: change   type: filter assignee   Ctrl+O: private note   Ctrl+R: template   Ctrl+S: apply   Esc: cancel",
 		Width:       m.width,
 		Height:      m.height,
-		BorderColor: "#C678DD",
-		TitleColor:  "#FFFFFF",
+		BorderColor: appui.Theme.Special,
+		TitleColor:  appui.Theme.Title,
 		BoxWidth:    66,
 	})
 }
@@ -240,8 +343,8 @@ func (m Model) renderStatusPicker() string {
 	if len(m.availableStatuses) == 0 {
 		lines = append(lines, "Loading statuses...")
 	}
-	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700")).Bold(true)
-	currentStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#98C379"))
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	currentStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Success))
 	for i, st := range m.availableStatuses {
 		prefix := "  "
 		if i == m.statusPickCursor {
@@ -265,8 +368,8 @@ func (m Model) renderStatusPicker() string {
 		Content:     lines,
 		Width:       m.width,
 		Height:      m.height,
-		BorderColor: "#FFD700",
-		TitleColor:  "#FFFFFF",
+		BorderColor: appui.Theme.Cursor,
+		TitleColor:  appui.Theme.Title,
 	})
 }
 
@@ -278,31 +381,23 @@ func (m Model) renderDescEditor() string {
 	}
 	return appui.RenderInputModal(appui.InputModalConfig{
 		Title:       title,
-		Body:        m.descInput.View(),
+		Body:        withList(m.descInput.View(), m.renderCompletion()),
 		Hint:        "Ctrl+S: Save   Esc: Cancel   (Enter inserts a new line)",
 		Width:       m.width,
 		Height:      m.height,
-		BorderColor: "#61AFEF",
-		TitleColor:  "#FFFFFF",
+		BorderColor: appui.Theme.Accent,
+		TitleColor:  appui.Theme.Title,
 		BoxWidth:    66,
 	})
 }
 
 // getFooterItems returns footer menu items with required status
 func (m Model) getFooterItems() []appui.FooterItem {
-	return []appui.FooterItem{
-		{Text: "↑↓/jk: Nav", Required: false},
-		{Text: "Tab: Switch", Required: false},
-		{Text: "f: Filter", Required: true},
-		{Text: "m: My/All", Required: true},
-		{Text: "r: Reload", Required: true},
-		{Text: "a: Actions", Required: true},
-		{Text: "e: Edit", Required: true},
-		{Text: "s: Status", Required: true},
-		{Text: "c: Note", Required: true},
-		{Text: "?: Help", Required: false},
-		{Text: "q: Quit", Required: true},
+	items := m.keys.footer()
+	if m.viewNotice != "" {
+		items = append([]appui.FooterItem{{Text: m.viewNotice, Required: true}}, items...)
 	}
+	return items
 }
 
 // renderListOverlay renders the user/project selection list overlay
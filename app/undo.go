@@ -0,0 +1,326 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// fieldChange is one field's value before and after a change. Old is what
+// gets sent back to the API to revert it (nil clears the field).
+type fieldChange struct {
+	Field   string
+	Old     interface{}
+	OldText string
+	NewText string
+}
+
+// changeRecord is one successful update in the session change log
+type changeRecord struct {
+	Seq     int
+	IssueID int
+	At      time.Time
+	Changes []fieldChange
+	Undone  bool
+}
+
+// undoableFields are the update fields whose previous value can be restored,
+// with the labels used in the change log and revert notes.
+var undoableFields = []struct{ Field, Label string }{
+	{"subject", "Subject"},
+	{"description", "Description"},
+	{"project_id", "Project"},
+	{"status_id", "Status"},
+	{"tracker_id", "Tracker"},
+	{"priority_id", "Priority"},
+	{"assigned_to_id", "Assignee"},
+	{"category_id", "Category"},
+	{"fixed_version_id", "Target version"},
+	{"done_ratio", "% Done"},
+	{"estimated_hours", "Estimated time"},
+	{"start_date", "Start date"},
+	{"due_date", "Due date"},
+}
+
+// recordChange captures the current values of the fields an update is about
+// to change, so the update can be undone later. It returns nil if the issue
+// is unknown or nothing in the update can be reverted (e.g. a note only).
+func (m *Model) recordChange(issueID int, updates map[string]interface{}) *changeRecord {
+	var issue *api.Issue
+	for i := range m.issues {
+		if m.issues[i].ID == issueID {
+			issue = &m.issues[i]
+			break
+		}
+	}
+	if issue == nil {
+		return nil
+	}
+	return m.recordChangeFrom(*issue, updates)
+}
+
+// recordChangeFrom is like recordChange but takes the previous values from
+// the given snapshot of the issue, for views that change issues locally
+// before saving.
+func (m *Model) recordChangeFrom(issue api.Issue, updates map[string]interface{}) *changeRecord {
+	rec := &changeRecord{IssueID: issue.ID}
+	for _, f := range undoableFields {
+		value, ok := updates[f.Field]
+		if !ok {
+			continue
+		}
+		old, oldText := previousValue(issue, f.Field)
+		rec.Changes = append(rec.Changes, fieldChange{
+			Field:   f.Field,
+			Old:     old,
+			OldText: oldText,
+			NewText: m.updateValueText(f.Field, value),
+		})
+	}
+	if len(rec.Changes) == 0 {
+		return nil
+	}
+	return rec
+}
+
+// previousValue returns an issue's current value for an update field, both
+// as it must be sent to the API and as display text.
+func previousValue(issue api.Issue, field string) (interface{}, string) {
+	switch field {
+	case "subject":
+		return issue.Subject, issue.Subject
+	case "description":
+		return issue.Description, excerpt(issue.Description, 40)
+	case "status_id":
+		return issue.Status.ID, issue.Status.Name
+	case "priority_id":
+		return issue.Priority.ID, issue.Priority.Name
+	case "project_id":
+		return issue.Project.ID, issue.Project.Name
+	case "tracker_id":
+		return issue.Tracker.ID, issue.Tracker.Name
+	case "category_id":
+		if issue.Category == nil {
+			return nil, noCategory
+		}
+		return issue.Category.ID, issue.Category.Name
+	case "assigned_to_id":
+		if issue.AssignedTo == nil {
+			return nil, "Unassigned"
+		}
+		return issue.AssignedTo.ID, issue.AssignedTo.Name
+	case "fixed_version_id":
+		if issue.FixedVersion == nil {
+			return nil, noVersion
+		}
+		return issue.FixedVersion.ID, issue.FixedVersion.Name
+	case "done_ratio":
+		return issue.DoneRatio, fmt.Sprintf("%d%%", issue.DoneRatio)
+	case "estimated_hours":
+		if issue.EstimatedHours == nil {
+			return nil, "none"
+		}
+		return *issue.EstimatedHours, formatHours(*issue.EstimatedHours) + "h"
+	case "start_date", "due_date":
+		date := issue.DueDate
+		if field == "start_date" {
+			date = issue.StartDate
+		}
+		if date == "" {
+			return nil, "none"
+		}
+		return date, date
+	}
+	return nil, ""
+}
+
+// updateValueText renders a value from an update map for display
+func (m *Model) updateValueText(field string, value interface{}) string {
+	if value == nil {
+		switch field {
+		case "assigned_to_id":
+			return "Unassigned"
+		case "fixed_version_id":
+			return noVersion
+		case "category_id":
+			return noCategory
+		}
+		return "none"
+	}
+	text := fmt.Sprint(value)
+	if kind, ok := lookupFields[field]; ok {
+		return m.lookupName(kind, text)
+	}
+	switch field {
+	case "done_ratio":
+		return text + "%"
+	case "estimated_hours":
+		return text + "h"
+	case "description":
+		return excerpt(text, 40)
+	}
+	return text
+}
+
+// fieldLabel returns the change-log label for an update field
+func fieldLabel(field string) string {
+	for _, f := range undoableFields {
+		if f.Field == field {
+			return f.Label
+		}
+	}
+	return field
+}
+
+// summary describes the change on one line, e.g. "Status: New → Resolved"
+func (c changeRecord) summary() string {
+	parts := make([]string, 0, len(c.Changes))
+	for _, fc := range c.Changes {
+		parts = append(parts, fmt.Sprintf("%s: %s → %s", fieldLabel(fc.Field), fc.OldText, fc.NewText))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// logChange appends a successful update to the change log
+func (m *Model) logChange(rec *changeRecord) {
+	m.changeSeq++
+	rec.Seq = m.changeSeq
+	rec.At = time.Now()
+	m.changeLog = append(m.changeLog, *rec)
+}
+
+// lastUndoable returns the index of the most recent change not yet undone
+func (m *Model) lastUndoable() int {
+	for i := len(m.changeLog) - 1; i >= 0; i-- {
+		if !m.changeLog[i].Undone {
+			return i
+		}
+	}
+	return -1
+}
+
+// undoChange reverts a logged change and adds a note saying so
+func (m *Model) undoChange(idx int) tea.Cmd {
+	if idx < 0 || idx >= len(m.changeLog) || m.changeLog[idx].Undone {
+		return nil
+	}
+	rec := m.changeLog[idx]
+	m.loading = true
+	return tea.Batch(
+		appui.SendLoadingMsg(fmt.Sprintf("Reverting change on #%d...", rec.IssueID)),
+		revertChange(m.client, rec),
+	)
+}
+
+// revertChange sends a change's previous values back with a revert note
+func revertChange(client *api.Client, rec changeRecord) tea.Cmd {
+	updates := make(map[string]interface{})
+	var reverted []string
+	for _, fc := range rec.Changes {
+		updates[fc.Field] = fc.Old
+		reverted = append(reverted, fmt.Sprintf("%s: %s → %s", fieldLabel(fc.Field), fc.NewText, fc.OldText))
+	}
+	updates["notes"] = fmt.Sprintf("Reverted change from %s (%s)",
+		rec.At.Local().Format("2006-01-02 15:04"), strings.Join(reverted, ", "))
+	return func() tea.Msg {
+		err := client.UpdateIssue(rec.IssueID, updates)
+		return issueUpdatedMsg{issueID: rec.IssueID, undoOf: rec.Seq, err: err}
+	}
+}
+
+// markUndone flags the change with the given sequence number as reverted
+func (m *Model) markUndone(seq int) {
+	for i := range m.changeLog {
+		if m.changeLog[i].Seq == seq {
+			m.changeLog[i].Undone = true
+			return
+		}
+	}
+}
+
+// openChangeLog shows the session change log, newest entry selected
+func (m *Model) openChangeLog() {
+	m.showModal = true
+	m.modalType = "changes"
+	m.modalScroll = 0
+	m.changeCursor = len(m.changeLog) - 1
+}
+
+// updateChangeLog handles keys while the change log is open. Entries are
+// listed newest first; Enter (or z) undoes the selected one.
+func (m Model) updateChangeLog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "Z", "q":
+		m.showModal = false
+		m.modalType = ""
+		return m, nil
+	case "up", "k":
+		if m.changeCursor < len(m.changeLog)-1 {
+			m.changeCursor++
+		}
+	case "down", "j":
+		if m.changeCursor > 0 {
+			m.changeCursor--
+		}
+	case "enter", "z":
+		if m.changeCursor < 0 || m.changeCursor >= len(m.changeLog) || m.changeLog[m.changeCursor].Undone {
+			return m, nil
+		}
+		m.showModal = false
+		m.modalType = ""
+		return m, m.undoChange(m.changeCursor)
+	}
+	return m, nil
+}
+
+// renderChangeLog renders the session change log as a modal
+func (m Model) renderChangeLog() string {
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Title)).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+
+	var lines []string
+	if len(m.changeLog) == 0 {
+		lines = append(lines, dimStyle.Render("No changes made in this session."))
+	}
+	// Two lines per entry, newest first; keep the cursor in view
+	visible := 10
+	for i := len(m.changeLog) - 1; i >= 0; i-- {
+		pos := len(m.changeLog) - 1 - i
+		if cursorPos := len(m.changeLog) - 1 - m.changeCursor; cursorPos >= visible && pos <= cursorPos-visible {
+			continue
+		}
+		rec := m.changeLog[i]
+		title := fmt.Sprintf("#%d", rec.IssueID)
+		prefix := "  "
+		if i == m.changeCursor {
+			prefix = "→ "
+			title = cursorStyle.Render(title)
+		} else {
+			title = titleStyle.Render(title)
+		}
+		stamp := rec.At.Local().Format("15:04:05")
+		if rec.Undone {
+			stamp += " · undone"
+		}
+		lines = append(lines,
+			prefix+title+" "+dimStyle.Render(stamp),
+			"    "+excerpt(rec.summary(), 54),
+		)
+	}
+
+	return appui.RenderModal(appui.ModalConfig{
+		Title:       "Changes this session (Enter: undo, Esc: close)",
+		Content:     lines,
+		Width:       m.width,
+		Height:      m.height,
+		BorderColor: appui.Theme.Special,
+		TitleColor:  appui.Theme.Title,
+	})
+}
@@ -0,0 +1,152 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/aymanbagabas/go-osc52/v2"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ktsopanakis/redmine-tui/api"
+	appui "github.com/ktsopanakis/redmine-tui/ui"
+)
+
+// yankOption is one way of copying the selected issue
+type yankOption struct {
+	label string
+	text  string
+}
+
+// yankedMsg reports how a copy went
+type yankedMsg struct {
+	text string
+	via  string
+	err  error
+}
+
+// issueURL returns the web URL of an issue
+func (m *Model) issueURL(issueID int) string {
+	return fmt.Sprintf("%s/issues/%d", m.client.BaseURL, issueID)
+}
+
+// yankOptions lists what the yank menu can copy for an issue
+func (m *Model) yankOptions(issue api.Issue) []yankOption {
+	url := m.issueURL(issue.ID)
+	ref := fmt.Sprintf("#%d", issue.ID)
+	title := fmt.Sprintf("#%d: %s", issue.ID, issue.Subject)
+	return []yankOption{
+		{"URL", url},
+		{"Issue ID", ref},
+		{"ID and subject", title},
+		{"Markdown link", fmt.Sprintf("[%s](%s)", title, url)},
+	}
+}
+
+// openYankMenu shows the copy options for the selected issue
+func (m *Model) openYankMenu() {
+	if m.editingIssue() == nil {
+		return
+	}
+	m.showModal = true
+	m.modalType = "yank"
+	m.yankCursor = 0
+}
+
+// updateYankMenu handles keys while the yank menu is open: ↑/↓ or a digit
+// select, Enter (or y) copies
+func (m Model) updateYankMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	issue := m.editingIssue()
+	if issue == nil {
+		m.showModal = false
+		m.modalType = ""
+		return m, nil
+	}
+	options := m.yankOptions(*issue)
+	pick := -1
+	switch msg.String() {
+	case "esc", "q":
+		m.showModal = false
+		m.modalType = ""
+	case "up", "k":
+		if m.yankCursor > 0 {
+			m.yankCursor--
+		}
+	case "down", "j":
+		if m.yankCursor < len(options)-1 {
+			m.yankCursor++
+		}
+	case "enter", "y":
+		pick = m.yankCursor
+	default:
+		if s := msg.String(); len(s) == 1 && s[0] >= '1' && s[0] <= '9' {
+			pick = int(s[0] - '1')
+		}
+	}
+	if pick < 0 || pick >= len(options) {
+		return m, nil
+	}
+	m.showModal = false
+	m.modalType = ""
+	return m, copyToClipboard(options[pick].text)
+}
+
+// renderYankMenu renders the copy options as a modal
+func (m Model) renderYankMenu() string {
+	issue := m.editingIssue()
+	if issue == nil {
+		return ""
+	}
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Cursor)).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Accent))
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(appui.Theme.Muted))
+
+	var lines []string
+	for i, o := range m.yankOptions(*issue) {
+		prefix := "  "
+		label := labelStyle.Render(fmt.Sprintf("%d. %s", i+1, o.label))
+		if i == m.yankCursor {
+			prefix = "→ "
+			label = cursorStyle.Render(fmt.Sprintf("%d. %s", i+1, o.label))
+		}
+		lines = append(lines, prefix+label, "     "+dimStyle.Render(excerpt(o.text, 54)))
+	}
+	return appui.RenderModal(appui.ModalConfig{
+		Title:       fmt.Sprintf("Copy #%d (1-4/Enter: copy, Esc: close)", issue.ID),
+		Content:     lines,
+		Width:       m.width,
+		Height:      m.height,
+		BorderColor: appui.Theme.Accent,
+		TitleColor:  appui.Theme.Title,
+	})
+}
+
+// copyToClipboard copies text to the clipboard through the terminal
+// (OSC52), which works over SSH, and also through the system clipboard
+// (xclip, xsel, wl-copy, pbcopy...) where there is one, since not every
+// terminal supports OSC52
+func copyToClipboard(text string) tea.Cmd {
+	return func() tea.Msg {
+		seq := osc52.New(text)
+		switch {
+		case os.Getenv("TMUX") != "":
+			seq = seq.Tmux()
+		case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+			seq = seq.Screen()
+		}
+		_, oscErr := seq.WriteTo(os.Stderr)
+
+		local := os.Getenv("SSH_TTY") == "" && os.Getenv("SSH_CONNECTION") == ""
+		if local && !clipboard.Unsupported {
+			if err := clipboard.WriteAll(text); err == nil {
+				return yankedMsg{text: text, via: "system clipboard"}
+			}
+		}
+		if oscErr != nil {
+			return yankedMsg{text: text, err: oscErr}
+		}
+		return yankedMsg{text: text, via: "terminal"}
+	}
+}
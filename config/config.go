@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -12,19 +13,66 @@ type Settings struct {
 	Redmine struct {
 		URL    string `yaml:"url"`
 		APIKey string `yaml:"api_key"`
+		// TextFormatting is the server's text formatting setting, used when
+		// quoting notes: "textile" (default), "markdown" or "common_mark"
+		TextFormatting string `yaml:"text_formatting"`
 	} `yaml:"redmine"`
+	API struct {
+		MaxRetries     int           `yaml:"max_retries"`      // retries for failed GETs (0 = default, -1 = disabled)
+		RetryBaseDelay time.Duration `yaml:"retry_base_delay"` // e.g. "500ms"
+		RetryMaxDelay  time.Duration `yaml:"retry_max_delay"`  // e.g. "5s"
+	} `yaml:"api"`
+	Refresh struct {
+		Interval time.Duration `yaml:"interval"` // background poll interval, e.g. "2m" (0 = disabled)
+	} `yaml:"refresh"`
+	Notifications struct {
+		Command  string        `yaml:"command"`  // e.g. "notify-send"; title and body are appended as arguments
+		Interval time.Duration `yaml:"interval"` // how often to check, e.g. "5m" (0 = the refresh interval)
+	} `yaml:"notifications"`
+	Browser struct {
+		Command string `yaml:"command"` // e.g. "firefox"; the URL is appended (default: $BROWSER, then xdg-open/open)
+	} `yaml:"browser"`
+	// Keys overrides the list view's key bindings by action, e.g.
+	// "reload: ctrl+r" or "quit: [q, Q]"; an empty list unbinds the action
+	Keys map[string]KeyList `yaml:"keys,omitempty"`
+	// Theme is a built-in theme (dark, light, high-contrast, solarized,
+	// monochrome) or the path of a theme file; see LoadTheme
+	Theme string `yaml:"theme,omitempty"`
+	// Colors overrides the dark theme's pane, header and footer colors when
+	// no theme is set (kept for older config files)
 	Colors struct {
-		ActivePaneBorder   string `yaml:"active_pane_border"`
-		InactivePaneBorder string `yaml:"inactive_pane_border"`
-		HeaderBackground   string `yaml:"header_background"`
-		HeaderText         string `yaml:"header_text"`
-		FooterBackground   string `yaml:"footer_background"`
-		FooterText         string `yaml:"footer_text"`
-	} `yaml:"colors"`
+		ActivePaneBorder   string `yaml:"active_pane_border,omitempty"`
+		InactivePaneBorder string `yaml:"inactive_pane_border,omitempty"`
+		HeaderBackground   string `yaml:"header_background,omitempty"`
+		HeaderText         string `yaml:"header_text,omitempty"`
+		FooterBackground   string `yaml:"footer_background,omitempty"`
+		FooterText         string `yaml:"footer_text,omitempty"`
+	} `yaml:"colors,omitempty"`
 }
 
 var Current Settings
 
+// KeyList is the keys bound to an action. The config file may give a single
+// key or a list of keys.
+type KeyList []string
+
+// UnmarshalYAML accepts both "r" and [r, ctrl+r]
+func (k *KeyList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*k = nil
+		if value.Value != "" {
+			*k = KeyList{value.Value}
+		}
+		return nil
+	}
+	var keys []string
+	if err := value.Decode(&keys); err != nil {
+		return err
+	}
+	*k = keys
+	return nil
+}
+
 // GetConfigPath returns the path to the config file in the user's home directory
 func GetConfigPath() (string, error) {
 	home, err := os.UserHomeDir()
@@ -80,16 +128,20 @@ func saveSettings() error {
 	return os.WriteFile(configPath, data, 0600)
 }
 
+// SaveAPIKey replaces the configured API key and persists the config file
+func SaveAPIKey(apiKey string) error {
+	Current.Redmine.APIKey = apiKey
+	if err := ensureConfigDir(); err != nil {
+		return fmt.Errorf("could not create config directory: %w", err)
+	}
+	if err := saveSettings(); err != nil {
+		return fmt.Errorf("could not save config: %w", err)
+	}
+	return nil
+}
+
 // PromptForRedmineSetup interactively asks for Redmine URL and API key
 func PromptForRedmineSetup() error {
-	// Set default colors first
-	Current.Colors.ActivePaneBorder = "#FF00FF"
-	Current.Colors.InactivePaneBorder = "#874BFD"
-	Current.Colors.HeaderBackground = "#7D56F4"
-	Current.Colors.HeaderText = "#FAFAFA"
-	Current.Colors.FooterBackground = "#3C3C3C"
-	Current.Colors.FooterText = "#FAFAFA"
-
 	fmt.Println("\n=== Redmine TUI Setup ===")
 	fmt.Println("Please enter your Redmine configuration:")
 
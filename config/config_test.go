@@ -3,7 +3,10 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+
+	"gopkg.in/yaml.v3"
 )
 
 func TestGetConfigPath(t *testing.T) {
@@ -39,3 +42,134 @@ func TestLoadNonExistentConfig(t *testing.T) {
 		t.Error("Load() should fail with non-existent config")
 	}
 }
+
+func TestLoadTemplates(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	templates, err := LoadTemplates()
+	if err != nil || templates != nil {
+		t.Fatalf("missing file: got %v, %v; want no templates", templates, err)
+	}
+
+	path, err := GetTemplatesPath()
+	if err != nil {
+		t.Fatalf("GetTemplatesPath() failed: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `templates:
+  - name: Need more info
+    text: "Hi {{author}}, could you attach the log?"
+    status: Feedback
+    assignee: author
+  - text: nameless entries are skipped
+`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	templates, err = LoadTemplates()
+	if err != nil {
+		t.Fatalf("LoadTemplates() failed: %v", err)
+	}
+	want := NoteTemplate{Name: "Need more info", Text: "Hi {{author}}, could you attach the log?", Status: "Feedback", Assignee: "author"}
+	if len(templates) != 1 || templates[0] != want {
+		t.Errorf("templates = %+v, want [%+v]", templates, want)
+	}
+
+	if err := os.WriteFile(path, []byte("templates: ["), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTemplates(); err == nil {
+		t.Error("LoadTemplates() should fail on invalid YAML")
+	}
+}
+
+func TestKeyList(t *testing.T) {
+	var settings Settings
+	data := `keys:
+  reload: ctrl+r
+  quit: [q, Q]
+  mark: []
+`
+	if err := yaml.Unmarshal([]byte(data), &settings); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := settings.Keys["reload"]; len(got) != 1 || got[0] != "ctrl+r" {
+		t.Errorf("reload = %v, want [ctrl+r]", got)
+	}
+	if got := settings.Keys["quit"]; len(got) != 2 || got[1] != "Q" {
+		t.Errorf("quit = %v, want [q Q]", got)
+	}
+	if got, ok := settings.Keys["mark"]; !ok || len(got) != 0 {
+		t.Errorf("mark = %v (set: %v), want an empty list", got, ok)
+	}
+}
+
+func TestLoadTheme(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defer func(c Settings) { Current = c }(Current)
+
+	// No theme: dark, with the legacy colors section on top
+	Current = Settings{}
+	Current.Colors.HeaderBackground = "#123456"
+	theme, err := LoadTheme()
+	if err != nil {
+		t.Fatalf("LoadTheme() failed: %v", err)
+	}
+	if theme.HeaderBackground != "#123456" || theme.Accent != Themes["dark"].Accent {
+		t.Errorf("default theme = %+v, want dark with the configured header", theme)
+	}
+
+	Current.Theme = "solarized"
+	if theme, err := LoadTheme(); err != nil || theme != Themes["solarized"] {
+		t.Errorf("solarized: got %+v, %v", theme, err)
+	}
+
+	Current.Theme = "neon"
+	if _, err := LoadTheme(); err == nil || !strings.Contains(err.Error(), "high-contrast") {
+		t.Errorf("an unknown theme should list the built-in ones, got %v", err)
+	}
+
+	// A theme file starts from its base theme
+	configPath, _ := GetConfigPath()
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := "base: light\naccent: \"#FF8800\"\n"
+	if err := os.WriteFile(filepath.Join(filepath.Dir(configPath), "mine.yaml"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	Current.Theme = "mine.yaml"
+	theme, err = LoadTheme()
+	if err != nil {
+		t.Fatalf("LoadTheme(mine.yaml) failed: %v", err)
+	}
+	if theme.Accent != "#FF8800" || theme.Title != Themes["light"].Title {
+		t.Errorf("theme file = %+v, want light with an orange accent", theme)
+	}
+
+	// reverse_selection can be turned off as well as on, and is kept from
+	// the base when the file doesn't set it
+	for data, want := range map[string]bool{
+		"base: high-contrast\nreverse_selection: false\n": false,
+		"base: high-contrast\n":                           true,
+		"base: dark\nreverse_selection: true\n":           true,
+	} {
+		if err := os.WriteFile(filepath.Join(filepath.Dir(configPath), "mine.yaml"), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		theme, err = LoadTheme()
+		if err != nil {
+			t.Fatalf("LoadTheme(%q) failed: %v", data, err)
+		}
+		if theme.ReverseSelection != want {
+			t.Errorf("theme file %q: reverse selection = %v, want %v", data, theme.ReverseSelection, want)
+		}
+	}
+
+	Current.Theme = "missing.yaml"
+	if _, err := LoadTheme(); err == nil {
+		t.Error("a missing theme file should fail")
+	}
+}
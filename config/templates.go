@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// NoteTemplate is a canned note response. Text may contain the placeholders
+// {{assignee}}, {{author}}, {{id}}, {{subject}}, {{me}} and {{date}}.
+type NoteTemplate struct {
+	Name     string `yaml:"name"`
+	Text     string `yaml:"text"`
+	Status   string `yaml:"status,omitempty"`   // status name to set along with the note
+	Assignee string `yaml:"assignee,omitempty"` // "author", "me", "nobody" or a user's login/name
+}
+
+// GetTemplatesPath returns the path to the note templates file, next to the
+// config file
+func GetTemplatesPath() (string, error) {
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(configPath), "templates.yaml"), nil
+}
+
+// LoadTemplates reads the note templates. A missing file means no templates.
+func LoadTemplates() ([]NoteTemplate, error) {
+	path, err := GetTemplatesPath()
+	if err != nil {
+		return nil, fmt.Errorf("could not determine templates path: %w", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not load templates: %w", err)
+	}
+
+	var file struct {
+		Templates []NoteTemplate `yaml:"templates"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("could not parse templates: %w", err)
+	}
+
+	var templates []NoteTemplate
+	for _, t := range file.Templates {
+		if t.Name != "" {
+			templates = append(templates, t)
+		}
+	}
+	return templates, nil
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Theme holds every color the UI uses. A color is a "#RRGGBB" hex value, an
+// ANSI color number ("0"-"255") or empty for the terminal's default.
+type Theme struct {
+	Title     string `yaml:"title"`      // titles and emphasized text
+	Muted     string `yaml:"muted"`      // hints, separators and secondary text
+	Accent    string `yaml:"accent"`     // labels, links and most dialog borders
+	Special   string `yaml:"special"`    // people, marks and the command palette
+	Cursor    string `yaml:"cursor"`     // the selected entry of menus, statuses
+	Warning   string `yaml:"warning"`    // changed values and pending work
+	Success   string `yaml:"success"`    // saved values, projects, new items
+	Error     string `yaml:"error"`      // errors and unsaved changes
+	IssueID   string `yaml:"issue_id"`   // issue numbers in the list
+	Selection string `yaml:"selection"`  // background of the selected row
+	OnAccent  string `yaml:"on_accent"`  // text drawn on an accent background
+	Backdrop  string `yaml:"backdrop"`   // background around dialogs
+	Spinner   string `yaml:"spinner"`    // loading spinner
+	Loading   string `yaml:"loading"`    // loading box border
+	LoadingBg string `yaml:"loading_bg"` // loading box background
+
+	ActivePaneBorder   string `yaml:"active_pane_border"`
+	InactivePaneBorder string `yaml:"inactive_pane_border"`
+	HeaderBackground   string `yaml:"header_background"`
+	HeaderText         string `yaml:"header_text"`
+	FooterBackground   string `yaml:"footer_background"`
+	FooterText         string `yaml:"footer_text"`
+
+	// ReverseSelection shows selections in reverse video rather than with
+	// the Selection background, for terminals with few or no colors.
+	// Theme files set it through themeFile.
+	ReverseSelection bool `yaml:"-"`
+}
+
+// Themes are the built-in themes by name
+var Themes = map[string]Theme{
+	"dark": {
+		Title: "#FFFFFF", Muted: "#666666", Accent: "#61AFEF", Special: "#C678DD",
+		Cursor: "#FFD700", Warning: "#E5C07B", Success: "#98C379", Error: "#E06C75",
+		IssueID: "#00D7FF", Selection: "#2A2A3A", OnAccent: "#000000", Backdrop: "#000000",
+		Spinner: "205", Loading: "63", LoadingBg: "235",
+		ActivePaneBorder: "#FF00FF", InactivePaneBorder: "#874BFD",
+		HeaderBackground: "#7D56F4", HeaderText: "#FAFAFA",
+		FooterBackground: "#3C3C3C", FooterText: "#FAFAFA",
+	},
+	"light": {
+		Title: "#1F2328", Muted: "#8C8C8C", Accent: "#4078F2", Special: "#A626A4",
+		Cursor: "#B35900", Warning: "#986801", Success: "#50A14F", Error: "#E45649",
+		IssueID: "#0184BC", Selection: "#E5E5E6", OnAccent: "#FFFFFF", Backdrop: "#FAFAFA",
+		Spinner: "#A626A4", Loading: "#4078F2", LoadingBg: "#F0F0F0",
+		ActivePaneBorder: "#A626A4", InactivePaneBorder: "#B8B8C8",
+		HeaderBackground: "#4078F2", HeaderText: "#FFFFFF",
+		FooterBackground: "#E5E5E6", FooterText: "#383A42",
+	},
+	// high-contrast sticks to the 16 basic colors, which terminals render
+	// with their own (usually readable) palette
+	"high-contrast": {
+		Title: "15", Muted: "7", Accent: "14", Special: "13",
+		Cursor: "11", Warning: "11", Success: "10", Error: "9",
+		IssueID: "14", OnAccent: "0", Backdrop: "0",
+		Spinner: "11", Loading: "15", LoadingBg: "0",
+		ActivePaneBorder: "11", InactivePaneBorder: "15",
+		HeaderBackground: "15", HeaderText: "0",
+		FooterBackground: "15", FooterText: "0",
+		ReverseSelection: true,
+	},
+	"solarized": {
+		Title: "#93A1A1", Muted: "#586E75", Accent: "#268BD2", Special: "#6C71C4",
+		Cursor: "#B58900", Warning: "#CB4B16", Success: "#859900", Error: "#DC322F",
+		IssueID: "#2AA198", Selection: "#073642", OnAccent: "#002B36", Backdrop: "#002B36",
+		Spinner: "#D33682", Loading: "#268BD2", LoadingBg: "#073642",
+		ActivePaneBorder: "#268BD2", InactivePaneBorder: "#586E75",
+		HeaderBackground: "#073642", HeaderText: "#93A1A1",
+		FooterBackground: "#073642", FooterText: "#839496",
+	},
+	// monochrome uses no colors at all, only bold and reverse video
+	"monochrome": {ReverseSelection: true},
+}
+
+// DefaultTheme is used when the config names no theme
+const DefaultTheme = "dark"
+
+// themeFile is the format of a custom theme file: a built-in theme to start
+// from and the colors to change. ReverseSelection is a pointer so a file
+// can turn it off as well as on.
+type themeFile struct {
+	Base             string `yaml:"base"`
+	ReverseSelection *bool  `yaml:"reverse_selection"`
+	Theme            `yaml:",inline"`
+}
+
+// LoadTheme returns the theme named by the config: a built-in theme, or the
+// path of a custom theme file (relative paths are next to the config file).
+// Without a theme, the dark theme is used with the legacy colors section
+// applied on top.
+func LoadTheme() (Theme, error) {
+	name := Current.Theme
+	if name == "" {
+		theme := Themes[DefaultTheme]
+		theme.overlay(Theme{
+			ActivePaneBorder:   Current.Colors.ActivePaneBorder,
+			InactivePaneBorder: Current.Colors.InactivePaneBorder,
+			HeaderBackground:   Current.Colors.HeaderBackground,
+			HeaderText:         Current.Colors.HeaderText,
+			FooterBackground:   Current.Colors.FooterBackground,
+			FooterText:         Current.Colors.FooterText,
+		})
+		return theme, nil
+	}
+	if theme, ok := Themes[name]; ok {
+		return theme, nil
+	}
+	if !strings.ContainsAny(name, `/\`) && filepath.Ext(name) == "" {
+		return Theme{}, fmt.Errorf("unknown theme %q (built-in: %s, or a theme file)", name, strings.Join(themeNames(), ", "))
+	}
+
+	path := name
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return Theme{}, err
+		}
+		path = filepath.Join(home, rest)
+	} else if !filepath.IsAbs(path) {
+		configPath, err := GetConfigPath()
+		if err != nil {
+			return Theme{}, err
+		}
+		path = filepath.Join(filepath.Dir(configPath), path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, fmt.Errorf("could not load theme: %w", err)
+	}
+	var file themeFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return Theme{}, fmt.Errorf("could not parse theme %s: %w", path, err)
+	}
+	if file.Base == "" {
+		file.Base = DefaultTheme
+	}
+	theme, ok := Themes[file.Base]
+	if !ok {
+		return Theme{}, fmt.Errorf("theme %s: unknown base theme %q", path, file.Base)
+	}
+	theme.overlay(file.Theme)
+	if file.ReverseSelection != nil {
+		theme.ReverseSelection = *file.ReverseSelection
+	}
+	return theme, nil
+}
+
+// overlay replaces the colors that other sets
+func (t *Theme) overlay(other Theme) {
+	set := func(dst *string, src string) {
+		if src != "" {
+			*dst = src
+		}
+	}
+	set(&t.Title, other.Title)
+	set(&t.Muted, other.Muted)
+	set(&t.Accent, other.Accent)
+	set(&t.Special, other.Special)
+	set(&t.Cursor, other.Cursor)
+	set(&t.Warning, other.Warning)
+	set(&t.Success, other.Success)
+	set(&t.Error, other.Error)
+	set(&t.IssueID, other.IssueID)
+	set(&t.Selection, other.Selection)
+	set(&t.OnAccent, other.OnAccent)
+	set(&t.Backdrop, other.Backdrop)
+	set(&t.Spinner, other.Spinner)
+	set(&t.Loading, other.Loading)
+	set(&t.LoadingBg, other.LoadingBg)
+	set(&t.ActivePaneBorder, other.ActivePaneBorder)
+	set(&t.InactivePaneBorder, other.InactivePaneBorder)
+	set(&t.HeaderBackground, other.HeaderBackground)
+	set(&t.HeaderText, other.HeaderText)
+	set(&t.FooterBackground, other.FooterBackground)
+	set(&t.FooterText, other.FooterText)
+}
+
+// themeNames lists the built-in themes
+func themeNames() []string {
+	names := make([]string, 0, len(Themes))
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -66,6 +66,14 @@ func main() {
 		os.Exit(1)
 	}
 
+	theme, err := config.LoadTheme()
+	if err != nil {
+		configPath, _ := config.GetConfigPath()
+		fmt.Fprintf(os.Stderr, "Error loading theme: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Please check the theme setting in: %s\n", configPath)
+		os.Exit(1)
+	}
+	ui.UseTheme(theme)
 	ui.InitStyles()
 
 	// Build program options
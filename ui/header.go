@@ -5,8 +5,6 @@ import (
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
-
-	"github.com/ktsopanakis/redmine-tui/config"
 )
 
 // HeaderSection represents a section of the header with color and content
@@ -21,7 +19,7 @@ type HeaderSection struct {
 // rightSections: array of sections to display on the right
 // width: total width of the header
 func RenderHeader(leftSections, rightSections []HeaderSection, width int) string {
-	bg := lipgloss.Color(config.Current.Colors.HeaderBackground)
+	bg := lipgloss.Color(Theme.HeaderBackground)
 
 	// Build left side
 	leftContent := ""
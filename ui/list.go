@@ -30,6 +30,8 @@ type ListConfig struct {
 	EmptyMessage    string                                  // Message to show when no items
 	ShowScrollInfo  bool                                    // Whether to show scroll position info
 	FilterFunc      func(item ListItem, filter string) bool // Custom filter function
+	Ranked          bool                                    // Items are already in display order (e.g. by match score); don't sort
+	NoCheckboxes    bool                                    // Plain list without selection checkboxes
 }
 
 // FilteredListResult contains the filtered list and updated indices
@@ -71,12 +73,14 @@ func BuildFilteredList(cfg ListConfig) FilteredListResult {
 	}
 
 	// Sort both groups alphabetically
-	sort.Slice(selectedItems, func(i, j int) bool {
-		return selectedItems[i].DisplayText < selectedItems[j].DisplayText
-	})
-	sort.Slice(unselectedItems, func(i, j int) bool {
-		return unselectedItems[i].DisplayText < unselectedItems[j].DisplayText
-	})
+	if !cfg.Ranked {
+		sort.Slice(selectedItems, func(i, j int) bool {
+			return selectedItems[i].DisplayText < selectedItems[j].DisplayText
+		})
+		sort.Slice(unselectedItems, func(i, j int) bool {
+			return unselectedItems[i].DisplayText < unselectedItems[j].DisplayText
+		})
+	}
 
 	// Combine: selected on top, then unselected
 	items := append(selectedItems, unselectedItems...)
@@ -156,15 +160,18 @@ func RenderListOverlay(cfg ListConfig, headerHeight, footerHeight int) string {
 
 	// Render visible items
 	for i := startIdx; i < endIdx; i++ {
-		checkbox := "[ ]"
+		checkbox := "[ ] "
 		if items[i].IsSelected {
-			checkbox = "[✓]"
+			checkbox = "[✓] "
+		}
+		if cfg.NoCheckboxes {
+			checkbox = ""
 		}
 		cursor := "  "
 		if i == filtered.UpdatedCursor {
 			cursor = "→ "
 		}
-		content.WriteString(fmt.Sprintf("%s%s %s\n", cursor, checkbox, items[i].DisplayText))
+		content.WriteString(fmt.Sprintf("%s%s%s\n", cursor, checkbox, items[i].DisplayText))
 	}
 
 	// Show scroll position indicator if needed
@@ -38,7 +38,7 @@ type LoadingModel struct {
 func NewLoadingModel() LoadingModel {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(Theme.Spinner))
 
 	return LoadingModel{
 		spinner:  s,
@@ -114,10 +114,10 @@ func (m LoadingModel) View() string {
 
 	style := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("63")).
+		BorderForeground(lipgloss.Color(Theme.Loading)).
 		Padding(0, 1).
 		Width(58). // Fixed width for consistent positioning
-		Background(lipgloss.Color("235"))
+		Background(lipgloss.Color(Theme.LoadingBg))
 
 	// Count in-progress messages
 	inProgress := 0
@@ -130,7 +130,7 @@ func (m LoadingModel) View() string {
 	if inProgress > 0 {
 		b.WriteString(fmt.Sprintf("%s Loading API calls...\n", m.spinner.View()))
 	} else {
-		greenCheck := lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Render("✓")
+		greenCheck := lipgloss.NewStyle().Foreground(lipgloss.Color(Theme.Success)).Render("✓")
 		b.WriteString(fmt.Sprintf("%s All operations complete\n", greenCheck))
 	}
 
@@ -152,12 +152,12 @@ func (m LoadingModel) View() string {
 		}
 
 		if msg.completed {
-			// Green checkmark for completed
-			checkmark := lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Render("✓")
+			// Checkmark for completed
+			checkmark := lipgloss.NewStyle().Foreground(lipgloss.Color(Theme.Success)).Render("✓")
 			b.WriteString(fmt.Sprintf("%s [%s] %s\n", checkmark, timestamp, text))
 		} else {
-			// Yellow dot for in-progress
-			dot := lipgloss.NewStyle().Foreground(lipgloss.Color("3")).Render("●")
+			// Dot for in-progress
+			dot := lipgloss.NewStyle().Foreground(lipgloss.Color(Theme.Warning)).Render("●")
 			b.WriteString(fmt.Sprintf("%s [%s] %s\n", dot, timestamp, text))
 		}
 	}
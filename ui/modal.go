@@ -74,16 +74,16 @@ func RenderModal(cfg ModalConfig) string {
 	if totalLines > maxVisibleLines {
 		content.WriteString("\n")
 		scrollInfo := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#666666")).
+			Foreground(lipgloss.Color(Theme.Muted)).
 			Render(strings.Repeat("─", 60))
 		content.WriteString(scrollInfo + "\n")
 
 		indicator := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#98C379")).
+			Foreground(lipgloss.Color(Theme.Success)).
 			Render("↑/↓ to scroll | ")
 
 		position := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#61AFEF")).
+			Foreground(lipgloss.Color(Theme.Accent)).
 			Render("Showing lines " + strings.Join([]string{
 				strings.Repeat(" ", len("Showing lines ")),
 			}, "") + " | Esc to close")
@@ -99,7 +99,7 @@ func RenderModal(cfg ModalConfig) string {
 		}
 
 		position = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#61AFEF")).
+			Foreground(lipgloss.Color(Theme.Accent)).
 			Render(posText + " | Esc to close")
 
 		content.WriteString(indicator + position)
@@ -108,7 +108,7 @@ func RenderModal(cfg ModalConfig) string {
 	// Create the modal box
 	borderColor := cfg.BorderColor
 	if borderColor == "" {
-		borderColor = "#61AFEF"
+		borderColor = Theme.Accent
 	}
 
 	box := lipgloss.NewStyle().
@@ -139,7 +139,7 @@ type InputModalConfig struct {
 func RenderInputModal(cfg InputModalConfig) string {
 	borderColor := cfg.BorderColor
 	if borderColor == "" {
-		borderColor = "#61AFEF"
+		borderColor = Theme.Accent
 	}
 	boxWidth := cfg.BoxWidth
 	if boxWidth == 0 {
@@ -158,7 +158,7 @@ func RenderInputModal(cfg InputModalConfig) string {
 	content.WriteString(cfg.Body)
 
 	if cfg.Hint != "" {
-		hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#666666"))
+		hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(Theme.Muted))
 		content.WriteString("\n\n" + hintStyle.Render(cfg.Hint))
 	}
 
@@ -190,8 +190,8 @@ func centerModal(box string, containerWidth, containerHeight int) string {
 
 	// Create background overlay style
 	overlayStyle := lipgloss.NewStyle().
-		Background(lipgloss.Color("#000000")).
-		Foreground(lipgloss.Color("#333333"))
+		Background(lipgloss.Color(Theme.Backdrop)).
+		Foreground(lipgloss.Color(Theme.Backdrop))
 
 	var result strings.Builder
 
@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
-
-	"github.com/ktsopanakis/redmine-tui/config"
 )
 
 // PaneConfig contains configuration for rendering a pane
@@ -27,9 +25,9 @@ func RenderPane(cfg PaneConfig) string {
 	if cfg.CustomColor != "" {
 		borderColor = lipgloss.Color(cfg.CustomColor)
 	} else if cfg.IsActive {
-		borderColor = lipgloss.Color(config.Current.Colors.ActivePaneBorder)
+		borderColor = lipgloss.Color(Theme.ActivePaneBorder)
 	} else {
-		borderColor = lipgloss.Color(config.Current.Colors.InactivePaneBorder)
+		borderColor = lipgloss.Color(Theme.InactivePaneBorder)
 	}
 
 	// Render pane with border
@@ -95,9 +93,9 @@ func RenderPaneWithColoredTitle(cfg PaneConfig, titleColor string) string {
 	if cfg.CustomColor != "" {
 		borderColor = lipgloss.Color(cfg.CustomColor)
 	} else if cfg.IsActive {
-		borderColor = lipgloss.Color(config.Current.Colors.ActivePaneBorder)
+		borderColor = lipgloss.Color(Theme.ActivePaneBorder)
 	} else {
-		borderColor = lipgloss.Color(config.Current.Colors.InactivePaneBorder)
+		borderColor = lipgloss.Color(Theme.InactivePaneBorder)
 	}
 
 	// Render pane with border
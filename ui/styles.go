@@ -1,7 +1,10 @@
 package ui
 
 import (
+	"os"
+
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 
 	"github.com/ktsopanakis/redmine-tui/config"
 )
@@ -13,25 +16,47 @@ var (
 	ActivePaneStyle lipgloss.Style
 )
 
+// Theme is the active color theme
+var Theme = config.Themes[config.DefaultTheme]
+
+// UseTheme makes theme the active theme. Without colors (NO_COLOR is set,
+// or the terminal has none) the monochrome theme is used instead.
+func UseTheme(theme config.Theme) {
+	if os.Getenv("NO_COLOR") != "" || lipgloss.ColorProfile() == termenv.Ascii {
+		theme = config.Themes["monochrome"]
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	Theme = theme
+}
+
+// SelectedStyle marks the selected row of a list: a background tint, or
+// reverse video for themes without one
+func SelectedStyle() lipgloss.Style {
+	if Theme.ReverseSelection {
+		return lipgloss.NewStyle().Reverse(true)
+	}
+	return lipgloss.NewStyle().Background(lipgloss.Color(Theme.Selection))
+}
+
 func InitStyles() {
 	HeaderStyle = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color(config.Current.Colors.HeaderText)).
-		Background(lipgloss.Color(config.Current.Colors.HeaderBackground)).
+		Foreground(lipgloss.Color(Theme.HeaderText)).
+		Background(lipgloss.Color(Theme.HeaderBackground)).
 		PaddingLeft(1)
 
 	FooterStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color(config.Current.Colors.FooterText)).
-		Background(lipgloss.Color(config.Current.Colors.FooterBackground)).
+		Foreground(lipgloss.Color(Theme.FooterText)).
+		Background(lipgloss.Color(Theme.FooterBackground)).
 		PaddingLeft(1)
 
 	PaneStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color(config.Current.Colors.InactivePaneBorder)).
+		BorderForeground(lipgloss.Color(Theme.InactivePaneBorder)).
 		Padding(0, 1)
 
 	ActivePaneStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color(config.Current.Colors.ActivePaneBorder)).
+		BorderForeground(lipgloss.Color(Theme.ActivePaneBorder)).
 		Padding(0, 1)
 }